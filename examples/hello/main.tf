@@ -15,8 +15,32 @@ provider "temporal" {
 }
 
 // Schedule round-trip
+locals {
+  holidays = [
+    { month = 1, day = 1 },
+    { month = 12, day = 25 },
+  ]
+}
+
 resource "temporal_schedule" "test" {
   id = "test-schedule"
+
+  interval {
+    every  = "1h"
+    offset = "15m"
+  }
+
+  dynamic "skip" {
+    for_each = local.holidays
+    content {
+      month        = [{ start = skip.value.month }]
+      day_of_month = [{ start = skip.value.day }]
+      hour         = [{ start = 0, end = 23 }]
+      minute       = [{ start = 0, end = 59 }]
+      second       = [{ start = 0, end = 59 }]
+      comment      = "holiday"
+    }
+  }
 }
 
 data "temporal_schedule" "test" {
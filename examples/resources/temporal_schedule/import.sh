@@ -0,0 +1,6 @@
+# Schedule in the provider's namespace
+terraform import temporal_schedule.example my-schedule
+
+# Schedule in another namespace, as <namespace>/<id>. An ID containing `/` is first
+# looked up as a whole in the provider's namespace
+terraform import temporal_schedule.example other-namespace/my-schedule
@@ -0,0 +1,111 @@
+// Package encryption provides an AES-GCM PayloadCodec using the envelope format
+// of the Temporal samples-go encryption sample, so payloads written by the provider
+// can be decrypted by workers and codec servers built from that sample.
+//
+// See https://github.com/temporalio/samples-go/tree/main/encryption
+package encryption
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"fmt"
+
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/sdk/converter"
+)
+
+const (
+	// MetadataEncodingEncrypted is the encoding of encrypted payloads.
+	MetadataEncodingEncrypted = "binary/encrypted"
+
+	// MetadataEncryptionKeyID is the payload metadata key holding the ID of the encryption key.
+	MetadataEncryptionKeyID = "encryption-key-id"
+)
+
+// Ensure Codec satisfies the Temporal SDK's PayloadCodec interface.
+var _ converter.PayloadCodec = &Codec{}
+
+// Codec encrypts payloads with AES-GCM using a single key.
+type Codec struct {
+	keyID string
+	aead  cipher.AEAD
+}
+
+// NewCodec creates a Codec for the AES key with the given ID.
+// The key must be 16, 24 or 32 bytes long, selecting AES-128, AES-192 or AES-256.
+func NewCodec(keyID string, key []byte) (*Codec, error) {
+	if keyID == "" {
+		return nil, fmt.Errorf("key ID must not be empty")
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	return &Codec{keyID: keyID, aead: aead}, nil
+}
+
+// Encode implements converter.PayloadCodec.Encode.
+func (c *Codec) Encode(payloads []*commonpb.Payload) ([]*commonpb.Payload, error) {
+	result := make([]*commonpb.Payload, len(payloads))
+	for i, p := range payloads {
+		origBytes, err := p.Marshal()
+		if err != nil {
+			return payloads, err
+		}
+
+		nonce := make([]byte, c.aead.NonceSize())
+		if _, err := rand.Read(nonce); err != nil {
+			return payloads, err
+		}
+
+		result[i] = &commonpb.Payload{
+			Metadata: map[string][]byte{
+				converter.MetadataEncoding: []byte(MetadataEncodingEncrypted),
+				MetadataEncryptionKeyID:    []byte(c.keyID),
+			},
+			Data: c.aead.Seal(nonce, nonce, origBytes, nil),
+		}
+	}
+	return result, nil
+}
+
+// Decode implements converter.PayloadCodec.Decode.
+// Payloads that are not encrypted are returned unchanged.
+func (c *Codec) Decode(payloads []*commonpb.Payload) ([]*commonpb.Payload, error) {
+	result := make([]*commonpb.Payload, len(payloads))
+	for i, p := range payloads {
+		if string(p.Metadata[converter.MetadataEncoding]) != MetadataEncodingEncrypted {
+			result[i] = p
+			continue
+		}
+
+		keyID, ok := p.Metadata[MetadataEncryptionKeyID]
+		if !ok {
+			return payloads, fmt.Errorf("no encryption key id")
+		}
+		if string(keyID) != c.keyID {
+			return payloads, fmt.Errorf("unknown encryption key id '%s'", keyID)
+		}
+
+		nonceSize := c.aead.NonceSize()
+		if len(p.Data) < nonceSize {
+			return payloads, fmt.Errorf("ciphertext too short")
+		}
+		nonce, ciphertext := p.Data[:nonceSize], p.Data[nonceSize:]
+		plaintext, err := c.aead.Open(nil, nonce, ciphertext, nil)
+		if err != nil {
+			return payloads, err
+		}
+
+		result[i] = &commonpb.Payload{}
+		if err := result[i].Unmarshal(plaintext); err != nil {
+			return payloads, err
+		}
+	}
+	return result, nil
+}
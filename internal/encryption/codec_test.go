@@ -0,0 +1,57 @@
+package encryption
+
+import (
+	"bytes"
+	"testing"
+
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/sdk/converter"
+)
+
+func TestCodec(t *testing.T) {
+	codec, err := NewCodec("test-key", bytes.Repeat([]byte{0x42}, 32))
+	if err != nil {
+		t.Fatalf("unable to create codec: %s", err)
+	}
+
+	payload, err := converter.GetDefaultDataConverter().ToPayload("secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	plain := &commonpb.Payload{Metadata: map[string][]byte{converter.MetadataEncoding: []byte("json/plain")}, Data: []byte(`"plain"`)}
+
+	encoded, err := codec.Encode([]*commonpb.Payload{payload})
+	if err != nil {
+		t.Fatalf("unable to encode: %s", err)
+	}
+	if string(encoded[0].Metadata[converter.MetadataEncoding]) != MetadataEncodingEncrypted ||
+		string(encoded[0].Metadata[MetadataEncryptionKeyID]) != "test-key" {
+		t.Errorf("unexpected metadata %v", encoded[0].Metadata)
+	}
+	if bytes.Contains(encoded[0].Data, []byte("secret")) {
+		t.Error("expected payload data to be encrypted")
+	}
+
+	decoded, err := codec.Decode([]*commonpb.Payload{encoded[0], plain})
+	if err != nil {
+		t.Fatalf("unable to decode: %s", err)
+	}
+	if !decoded[0].Equal(payload) {
+		t.Errorf("expected %v, got %v", payload, decoded[0])
+	}
+	if decoded[1] != plain {
+		t.Error("expected unencrypted payload to pass through")
+	}
+
+	otherCodec, err := NewCodec("other-key", bytes.Repeat([]byte{0x24}, 32))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := otherCodec.Decode(encoded); err == nil {
+		t.Error("expected error decoding with an unknown key id")
+	}
+
+	if _, err := NewCodec("short-key", []byte("short")); err == nil {
+		t.Error("expected error for invalid key size")
+	}
+}
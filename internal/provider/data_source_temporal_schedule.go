@@ -7,11 +7,10 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-
-	temporalClient "go.temporal.io/sdk/client"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
@@ -23,13 +22,15 @@ func NewScheduleDataSource() datasource.DataSource {
 
 // NewScheduleDataSource defines the data source for a Scheduled Workflow.
 type ScheduleDataSource struct {
-	tclient temporalClient.Client
+	provider *TemporalProviderData
 }
 
 // ScheduleDataSourceModel describes the data source data model.
 type ScheduleDataSourceModel struct {
 	ScheduleId types.String `tfsdk:"id"`
+	Namespace  types.String `tfsdk:"namespace"`
 	DescJson   types.String `tfsdk:"desc"`
+	GrpcMeta   types.Map    `tfsdk:"grpc_meta"`
 }
 
 func (d *ScheduleDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -49,6 +50,15 @@ func (d *ScheduleDataSource) Schema(ctx context.Context, req datasource.SchemaRe
 				MarkdownDescription: "Schedule description in JSON",
 				Computed:            true,
 			},
+			"namespace": schema.StringAttribute{
+				MarkdownDescription: "Temporal namespace of the Schedule. Defaults to the provider's namespace",
+				Optional:            true,
+			},
+			"grpc_meta": schema.MapAttribute{
+				MarkdownDescription: "gRPC metadata headers sent on requests for this Schedule. Overrides the provider's `grpc_meta`",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
 		},
 	}
 }
@@ -59,15 +69,15 @@ func (d *ScheduleDataSource) Configure(ctx context.Context, req datasource.Confi
 		return
 	}
 
-	tclient, ok := req.ProviderData.(temporalClient.Client)
+	providerData, ok := req.ProviderData.(*TemporalProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected temporalClient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *TemporalProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
-	d.tclient = tclient
+	d.provider = providerData
 }
 
 func (d *ScheduleDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -78,8 +88,25 @@ func (d *ScheduleDataSource) Read(ctx context.Context, req datasource.ReadReques
 		return
 	}
 
+	ctx, diags := contextWithGrpcMeta(ctx, state.GrpcMeta)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tclient, err := d.provider.clients.get(state.Namespace.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("namespace"), "Temporal Error", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(d.provider.checkSchedulesSupported(ctx)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Fetch the Schedule's description from the Server
-	desc, err := d.tclient.ScheduleClient().GetHandle(ctx, state.ScheduleId.ValueString()).Describe(ctx)
+	desc, err := d.provider.describeSchedule(ctx, tclient, state.ScheduleId.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Temporal Error", fmt.Sprintf("Read: Unable to describe Schedule %s : %s", state.ScheduleId.ValueString(), err))
 		return
@@ -0,0 +1,92 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"go.temporal.io/api/workflowservice/v1"
+
+	temporalClient "go.temporal.io/sdk/client"
+)
+
+// serverCapabilities describes the features of the connected Temporal Server,
+// as reported by GetSystemInfo.
+type serverCapabilities struct {
+	serverVersion string
+	capabilities  *workflowservice.GetSystemInfoResponse_Capabilities
+}
+
+// require returns an error if the connected server does not support feature,
+// which is supported by servers from minVersion on.
+func (c *serverCapabilities) require(feature string, minVersion string, supported bool) error {
+	if supported {
+		return nil
+	}
+	serverVersion := c.serverVersion
+	if serverVersion == "" {
+		serverVersion = "unknown"
+	}
+	return fmt.Errorf("%s require Temporal Server >= %s; connected server is %s", feature, minVersion, serverVersion)
+}
+
+// requireSchedules returns an error if the connected server does not support Schedules.
+func (c *serverCapabilities) requireSchedules() error {
+	return c.require("Schedules", "1.20", c.capabilities.GetSupportsSchedules())
+}
+
+// capabilitiesCache holds the capabilities of the server behind the provider's connection,
+// fetched once on first use.  Failed fetches are not cached.
+type capabilitiesCache struct {
+	mu     sync.Mutex
+	cached *serverCapabilities
+	warned bool // whether a failed fetch was already reported
+}
+
+// serverCapabilities returns the capabilities of the connected Temporal Server.
+// All of the provider's clients share a connection, so the server is asked once.
+func (p *TemporalProviderData) serverCapabilities(ctx context.Context) (*serverCapabilities, error) {
+	p.capabilities.mu.Lock()
+	defer p.capabilities.mu.Unlock()
+	if p.capabilities.cached != nil {
+		return p.capabilities.cached, nil
+	}
+
+	tclient, err := p.clients.get("")
+	if err != nil {
+		return nil, err
+	}
+	capabilities, err := fetchServerCapabilities(ctx, p.retry, tclient)
+	if err != nil {
+		return nil, err
+	}
+	p.capabilities.cached = capabilities
+	return capabilities, nil
+}
+
+// fetchServerCapabilities calls GetSystemInfo on the server behind tclient.
+func fetchServerCapabilities(ctx context.Context, retry retryPolicy, tclient temporalClient.Client) (*serverCapabilities, error) {
+	var resp *workflowservice.GetSystemInfoResponse
+	err := retry.call(ctx, "GetSystemInfo", true, func(ctx context.Context) error {
+		var err error
+		resp, err = tclient.WorkflowService().GetSystemInfo(ctx, &workflowservice.GetSystemInfoRequest{})
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to get Temporal Server system info: %w", err)
+	}
+	return &serverCapabilities{
+		serverVersion: resp.GetServerVersion(),
+		capabilities:  resp.GetCapabilities(),
+	}, nil
+}
+
+// warnDetectionFailure returns true the first time the capabilities cannot be detected,
+// so that the failure is reported once per provider rather than once per resource.
+func (c *capabilitiesCache) warnDetectionFailure() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	warn := !c.warned
+	c.warned = true
+	return warn
+}
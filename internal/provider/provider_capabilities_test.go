@@ -0,0 +1,112 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/api/workflowservice/v1"
+	"google.golang.org/grpc"
+
+	temporalClient "go.temporal.io/sdk/client"
+)
+
+// testSystemInfoService stubs GetSystemInfo, counting the calls.
+type testSystemInfoService struct {
+	workflowservice.WorkflowServiceClient
+	resp  *workflowservice.GetSystemInfoResponse
+	err   error
+	calls int
+}
+
+func (s *testSystemInfoService) GetSystemInfo(ctx context.Context, req *workflowservice.GetSystemInfoRequest, opts ...grpc.CallOption) (*workflowservice.GetSystemInfoResponse, error) {
+	s.calls++
+	return s.resp, s.err
+}
+
+type testSystemInfoClient struct {
+	temporalClient.Client
+	service *testSystemInfoService
+}
+
+func (c *testSystemInfoClient) WorkflowService() workflowservice.WorkflowServiceClient {
+	return c.service
+}
+
+func newTestCapabilitiesProvider(service *testSystemInfoService) *TemporalProviderData {
+	tclient := &testSystemInfoClient{service: service}
+	return &TemporalProviderData{
+		clients: newNamespaceClients(tclient, temporalClient.Options{Namespace: "default"}),
+	}
+}
+
+func TestServerCapabilities(t *testing.T) {
+	service := &testSystemInfoService{resp: &workflowservice.GetSystemInfoResponse{
+		ServerVersion: "1.18.5",
+		Capabilities:  &workflowservice.GetSystemInfoResponse_Capabilities{},
+	}}
+	providerData := newTestCapabilitiesProvider(service)
+
+	for i := 0; i < 2; i++ {
+		capabilities, err := providerData.serverCapabilities(context.Background())
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if capabilities.serverVersion != "1.18.5" {
+			t.Errorf("unexpected server version %q", capabilities.serverVersion)
+		}
+	}
+	if service.calls != 1 {
+		t.Errorf("expected GetSystemInfo to be called once, got %d calls", service.calls)
+	}
+
+	diags := providerData.checkSchedulesSupported(context.Background())
+	if !diags.HasError() {
+		t.Fatal("expected error for server without Schedules")
+	}
+	if detail := diags.Errors()[0].Detail(); detail != "Schedules require Temporal Server >= 1.20; connected server is 1.18.5" {
+		t.Errorf("unexpected detail %q", detail)
+	}
+
+	service.resp.Capabilities.SupportsSchedules = true
+	if diags := newTestCapabilitiesProvider(service).checkSchedulesSupported(context.Background()); diags.HasError() {
+		t.Errorf("unexpected diagnostics: %v", diags)
+	}
+}
+
+func TestServerCapabilitiesError(t *testing.T) {
+	service := &testSystemInfoService{err: serviceerror.NewPermissionDenied("denied", "")}
+	providerData := newTestCapabilitiesProvider(service)
+
+	diags := providerData.checkSchedulesSupported(context.Background())
+	if diags.HasError() || diags.WarningsCount() != 1 {
+		t.Fatalf("expected a single warning, got %v", diags)
+	}
+	if !strings.Contains(diags.Warnings()[0].Detail(), "denied") {
+		t.Errorf("unexpected warning %q", diags.Warnings()[0].Detail())
+	}
+
+	// The failure is only reported once per provider
+	if diags := providerData.checkSchedulesSupported(context.Background()); diags.WarningsCount() != 0 {
+		t.Errorf("expected no repeated warning, got %v", diags)
+	}
+
+	// Failures are not cached
+	service.err = nil
+	service.resp = &workflowservice.GetSystemInfoResponse{ServerVersion: "1.20.0"}
+	if _, err := providerData.serverCapabilities(context.Background()); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+	if service.calls != 3 {
+		t.Errorf("expected GetSystemInfo to be called again, got %d calls", service.calls)
+	}
+
+	var serviceErr *serviceerror.PermissionDenied
+	service.err = serviceerror.NewPermissionDenied("denied", "")
+	_, err := fetchServerCapabilities(context.Background(), retryPolicy{}, &testSystemInfoClient{service: service})
+	if !errors.As(err, &serviceErr) {
+		t.Errorf("expected wrapped PermissionDenied, got %v", err)
+	}
+}
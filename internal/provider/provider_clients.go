@@ -0,0 +1,65 @@
+package provider
+
+import (
+	"fmt"
+	"sync"
+
+	temporalClient "go.temporal.io/sdk/client"
+)
+
+// namespaceClients is a concurrency-safe cache of Temporal Clients keyed by namespace.
+// Every client shares the gRPC connection of the provider's default client.
+type namespaceClients struct {
+	defaultNamespace string
+	options          temporalClient.Options
+	newClient        func(existing temporalClient.Client, options temporalClient.Options) (temporalClient.Client, error)
+
+	mu      sync.Mutex
+	clients map[string]temporalClient.Client
+}
+
+// newNamespaceClients creates a cache around defaultClient, which was created with options.
+func newNamespaceClients(defaultClient temporalClient.Client, options temporalClient.Options) *namespaceClients {
+	return &namespaceClients{
+		defaultNamespace: options.Namespace,
+		options:          options,
+		newClient:        temporalClient.NewClientFromExisting,
+		clients: map[string]temporalClient.Client{
+			options.Namespace: defaultClient,
+		},
+	}
+}
+
+// get returns the client for namespace, creating it if needed.
+// An empty namespace returns the client for the provider's namespace.
+// Creating a client checks the server, so it happens outside the lock; if calls race, the first client stored wins.
+func (c *namespaceClients) get(namespace string) (temporalClient.Client, error) {
+	if namespace == "" {
+		namespace = c.defaultNamespace
+	}
+
+	c.mu.Lock()
+	tclient, ok := c.clients[namespace]
+	defaultClient := c.clients[c.defaultNamespace]
+	c.mu.Unlock()
+	if ok {
+		return tclient, nil
+	}
+
+	options := c.options
+	options.Namespace = namespace
+	tclient, err := c.newClient(defaultClient, options)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create Temporal Client for namespace '%s': %w", namespace, err)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if existing, ok := c.clients[namespace]; ok {
+		// Clients from NewClientFromExisting share the connection, so closing the extra one leaves it open
+		tclient.Close()
+		return existing, nil
+	}
+	c.clients[namespace] = tclient
+	return tclient, nil
+}
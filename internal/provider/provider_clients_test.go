@@ -0,0 +1,109 @@
+package provider
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	temporalClient "go.temporal.io/sdk/client"
+)
+
+// testNamespaceClient is a Temporal Client identified by its namespace.
+type testNamespaceClient struct {
+	temporalClient.Client
+	namespace string
+	closed    *int
+}
+
+// Close counts the clients closed, which namespaceClients only does while holding its lock.
+func (c *testNamespaceClient) Close() {
+	*c.closed++
+}
+
+// testClientNamespace returns the namespace of a testNamespaceClient, or "" for any other client.
+func testClientNamespace(tclient temporalClient.Client) string {
+	if c, ok := tclient.(*testNamespaceClient); ok {
+		return c.namespace
+	}
+	return ""
+}
+
+func newTestNamespaceClients(created *int, closed *int) *namespaceClients {
+	var mu sync.Mutex
+	clients := newNamespaceClients(&testNamespaceClient{namespace: "default"}, temporalClient.Options{Namespace: "default"})
+	clients.newClient = func(existing temporalClient.Client, options temporalClient.Options) (temporalClient.Client, error) {
+		if testClientNamespace(existing) != "default" {
+			return nil, fmt.Errorf("expected the default client to be shared")
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		*created++
+		return &testNamespaceClient{namespace: options.Namespace, closed: closed}, nil
+	}
+	return clients
+}
+
+func TestNamespaceClients(t *testing.T) {
+	created, closed := 0, 0
+	clients := newTestNamespaceClients(&created, &closed)
+
+	for _, namespace := range []string{"", "default"} {
+		tclient, err := clients.get(namespace)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if testClientNamespace(tclient) != "default" {
+			t.Errorf("expected the default client for namespace %q", namespace)
+		}
+	}
+	if created != 0 {
+		t.Errorf("expected no client to be created for the default namespace, got %d", created)
+	}
+
+	other, err := clients.get("other")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if namespace := testClientNamespace(other); namespace != "other" {
+		t.Errorf("unexpected namespace %q", namespace)
+	}
+	cached, err := clients.get("other")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if cached != other || created != 1 {
+		t.Errorf("expected the cached client to be reused, got %d clients created", created)
+	}
+	if closed != 0 {
+		t.Errorf("expected no client to be closed, got %d", closed)
+	}
+}
+
+func TestNamespaceClientsConcurrent(t *testing.T) {
+	created, closed := 0, 0
+	clients := newTestNamespaceClients(&created, &closed)
+
+	var wg sync.WaitGroup
+	results := make([]temporalClient.Client, 32)
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			tclient, err := clients.get(fmt.Sprintf("namespace-%d", i%4))
+			if err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+			results[i] = tclient
+		}(i)
+	}
+	wg.Wait()
+
+	if created-closed != 4 {
+		t.Errorf("expected one client kept per namespace, got %d created and %d closed", created, closed)
+	}
+	for i, tclient := range results {
+		if tclient != results[i%4] {
+			t.Errorf("expected calls %d and %d to share a client", i, i%4)
+		}
+	}
+}
@@ -0,0 +1,156 @@
+package provider
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+// Defaults for the provider's `cloud` block.
+const (
+	defaultCloudEndpoint   = "saas-api.tmprl.cloud:443"
+	defaultCloudAPIVersion = "v0.3.0"
+)
+
+// envCloudAPIKey is the environment variable holding the Temporal Cloud Ops API key.
+const envCloudAPIKey = "TEMPORAL_CLOUD_API_KEY"
+
+// cloudAPIVersionHeader is the gRPC metadata key selecting the Cloud Ops API version.
+const cloudAPIVersionHeader = "temporal-cloud-api-version"
+
+// TemporalProviderCloudModel describes the provider's `cloud` block.
+type TemporalProviderCloudModel struct {
+	APIKey     types.String `tfsdk:"api_key"`
+	Endpoint   types.String `tfsdk:"endpoint"`
+	APIVersion types.String `tfsdk:"api_version"`
+}
+
+func providerCloudBlock() schema.Block {
+	return schema.SingleNestedBlock{
+		MarkdownDescription: "Connection to the Temporal Cloud Operations API, used by resources managing Temporal Cloud accounts, " +
+			"such as namespaces, users and API keys. It is separate from the connection to the namespace frontend at `hostport`, " +
+			"and is only established when such a resource needs it.",
+		Attributes: map[string]schema.Attribute{
+			"api_key": schema.StringAttribute{
+				MarkdownDescription: "Temporal Cloud API key sent as a bearer token on every Cloud Operations API request. Overrides TEMPORAL_CLOUD_API_KEY",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"endpoint": schema.StringAttribute{
+				MarkdownDescription: "`host:port` of the Cloud Operations API. Defaults to `" + defaultCloudEndpoint + "`",
+				Optional:            true,
+			},
+			"api_version": schema.StringAttribute{
+				MarkdownDescription: "Cloud Operations API version, sent in the `" + cloudAPIVersionHeader + "` header. Defaults to `" + defaultCloudAPIVersion + "`",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+// cloudOpsClient lazily connects to the Temporal Cloud Operations API.
+// Cloud-scoped resources create the Cloud service clients on its connection.
+type cloudOpsClient struct {
+	endpoint    string
+	credentials cloudCredentials
+	tlsConfig   *tls.Config
+	dialOptions []grpc.DialOption
+
+	mu   sync.Mutex
+	conn *grpc.ClientConn
+}
+
+// newCloudOpsClient creates the Cloud Ops client from the `cloud` block.  dialOptions only carry
+// the transport, such as the provider's proxy: the frontend's request limits do not apply to
+// the Cloud Operations API.  Returns nil if the block is absent.
+func newCloudOpsClient(model *TemporalProviderCloudModel, dialOptions []grpc.DialOption) (*cloudOpsClient, error) {
+	if model == nil {
+		return nil, nil
+	}
+	setStringFromEnv(&model.APIKey, envCloudAPIKey)
+	if isStringUnset(model.APIKey) {
+		return nil, fmt.Errorf("'api_key' or environment '%s' must be set", envCloudAPIKey)
+	}
+
+	client := &cloudOpsClient{
+		endpoint: defaultCloudEndpoint,
+		credentials: cloudCredentials{
+			apiKey:     model.APIKey.ValueString(),
+			apiVersion: defaultCloudAPIVersion,
+		},
+		tlsConfig:   &tls.Config{MinVersion: tls.VersionTLS12},
+		dialOptions: dialOptions,
+	}
+	if !isStringUnset(model.Endpoint) {
+		client.endpoint = model.Endpoint.ValueString()
+	}
+	if !isStringUnset(model.APIVersion) {
+		client.credentials.apiVersion = model.APIVersion.ValueString()
+	}
+	return client, nil
+}
+
+// connection returns the connection to the Cloud Operations API, creating it on first use.
+func (c *cloudOpsClient) connection() (*grpc.ClientConn, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.conn != nil {
+		return c.conn, nil
+	}
+
+	dialOptions := append([]grpc.DialOption{
+		grpc.WithTransportCredentials(credentials.NewTLS(c.tlsConfig)),
+		grpc.WithPerRPCCredentials(c.credentials),
+	}, c.dialOptions...)
+	conn, err := grpc.Dial(c.endpoint, dialOptions...)
+	if err != nil {
+		return nil, fmt.Errorf("unable to connect to Temporal Cloud Operations API '%s': %w", c.endpoint, err)
+	}
+	c.conn = conn
+	return conn, nil
+}
+
+// close closes the connection to the Cloud Operations API, if it was established.
+// A later call to connection dials again.
+func (c *cloudOpsClient) close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.conn == nil {
+		return nil
+	}
+	err := c.conn.Close()
+	c.conn = nil
+	return err
+}
+
+// cloudConnection returns the connection to the Cloud Operations API for cloud-scoped resources.
+func (p *TemporalProviderData) cloudConnection() (*grpc.ClientConn, error) {
+	if p.cloud == nil {
+		return nil, fmt.Errorf("the provider's `cloud` block must be set to manage Temporal Cloud resources")
+	}
+	return p.cloud.connection()
+}
+
+// cloudCredentials attaches the API key and API version to every Cloud Operations API request.
+type cloudCredentials struct {
+	apiKey     string
+	apiVersion string
+}
+
+func (c cloudCredentials) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	return map[string]string{
+		"authorization":       "Bearer " + c.apiKey,
+		cloudAPIVersionHeader: c.apiVersion,
+	}, nil
+}
+
+// RequireTransportSecurity ensures the API key is never sent in the clear.
+func (c cloudCredentials) RequireTransportSecurity() bool {
+	return true
+}
@@ -0,0 +1,110 @@
+package provider
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestCloudOpsClient(t *testing.T) {
+	certPEM, keyPEM := testSelfSignedPEM(t)
+	cert, err := tls.X509KeyPair([]byte(certPEM), []byte(keyPEM))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Cloud Operations API stand-in, recording the metadata of each request
+	requestMeta := make(chan metadata.MD, 1)
+	server := grpc.NewServer(
+		grpc.Creds(credentials.NewTLS(&tls.Config{Certificates: []tls.Certificate{cert}, MinVersion: tls.VersionTLS12})),
+		grpc.UnaryInterceptor(func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+			md, _ := metadata.FromIncomingContext(ctx)
+			requestMeta <- md
+			return handler(ctx, req)
+		}),
+	)
+	grpc_health_v1.RegisterHealthServer(server, health.NewServer())
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	go func() { _ = server.Serve(listener) }()
+	defer server.Stop()
+
+	cloudClient, err := newCloudOpsClient(&TemporalProviderCloudModel{
+		APIKey:   types.StringValue("cloud-key"),
+		Endpoint: types.StringValue(listener.Addr().String()),
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cloudClient.tlsConfig = &tls.Config{InsecureSkipVerify: true} //nolint:gosec // self-signed test certificate
+
+	providerData := &TemporalProviderData{cloud: cloudClient}
+	conn, err := providerData.cloudConnection()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = cloudClient.close() }()
+	if again, _ := providerData.cloudConnection(); again != conn {
+		t.Error("expected the connection to be reused")
+	}
+
+	if _, err := grpc_health_v1.NewHealthClient(conn).Check(context.Background(), &grpc_health_v1.HealthCheckRequest{}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	md := <-requestMeta
+	if got := md.Get("authorization"); len(got) != 1 || got[0] != "Bearer cloud-key" {
+		t.Errorf("unexpected authorization %v", got)
+	}
+	if got := md.Get(cloudAPIVersionHeader); len(got) != 1 || got[0] != defaultCloudAPIVersion {
+		t.Errorf("unexpected API version %v", got)
+	}
+
+	// Closing releases the connection, and the next use dials again
+	if err := cloudClient.close(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if conn.GetState() != connectivity.Shutdown {
+		t.Errorf("expected the connection to be closed, got %s", conn.GetState())
+	}
+	redialed, err := providerData.cloudConnection()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if redialed == conn {
+		t.Error("expected a new connection after close")
+	}
+}
+
+func TestNewCloudOpsClient(t *testing.T) {
+	t.Setenv(envCloudAPIKey, "")
+
+	if cloudClient, err := newCloudOpsClient(nil, nil); err != nil || cloudClient != nil {
+		t.Errorf("expected no client without a block, got %v, %v", cloudClient, err)
+	}
+	if _, err := newCloudOpsClient(&TemporalProviderCloudModel{}, nil); err == nil {
+		t.Error("expected error without an API key")
+	}
+	if _, err := (&TemporalProviderData{}).cloudConnection(); err == nil {
+		t.Error("expected error without the cloud block")
+	}
+
+	t.Setenv(envCloudAPIKey, "env-key")
+	cloudClient, err := newCloudOpsClient(&TemporalProviderCloudModel{APIVersion: types.StringValue("v0.4.0")}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cloudClient.endpoint != defaultCloudEndpoint || cloudClient.credentials.apiKey != "env-key" || cloudClient.credentials.apiVersion != "v0.4.0" {
+		t.Errorf("unexpected client %+v", cloudClient)
+	}
+}
@@ -0,0 +1,42 @@
+package provider
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+
+	temporalClient "go.temporal.io/sdk/client"
+)
+
+// buildConnectionOptions maps the provider's `max_payload_size` and `keepalive_*` attributes
+// to the Temporal SDK's gRPC connection options.  Keepalive pings are enabled by setting
+// `keepalive_time` or `keepalive_timeout`, which `keepalive_permit_without_stream` requires.
+func buildConnectionOptions(config *TemporalProviderModel, diags *diag.Diagnostics) temporalClient.ConnectionOptions {
+	var connectionOptions temporalClient.ConnectionOptions
+	if !config.MaxPayloadSize.IsNull() {
+		maxPayloadSize := config.MaxPayloadSize.ValueInt64()
+		if maxPayloadSize <= 0 || maxPayloadSize > math.MaxInt32 {
+			diags.AddAttributeError(
+				path.Root("max_payload_size"),
+				"Invalid Temporal attribute 'max_payload_size'",
+				fmt.Sprintf("Temporal attribute 'max_payload_size' must be between 1 and %d bytes", math.MaxInt32),
+			)
+		}
+		connectionOptions.MaxPayloadSize = int(maxPayloadSize)
+	}
+
+	parseDurationAttribute(&connectionOptions.KeepAliveTime, config.KeepaliveTime, path.Root("keepalive_time"), diags)
+	parseDurationAttribute(&connectionOptions.KeepAliveTimeout, config.KeepaliveTimeout, path.Root("keepalive_timeout"), diags)
+	connectionOptions.EnableKeepAliveCheck = connectionOptions.KeepAliveTime > 0 || connectionOptions.KeepAliveTimeout > 0
+	connectionOptions.KeepAlivePermitWithoutStream = config.KeepalivePermit.ValueBool()
+	if connectionOptions.KeepAlivePermitWithoutStream && !connectionOptions.EnableKeepAliveCheck {
+		diags.AddAttributeError(
+			path.Root("keepalive_permit_without_stream"),
+			"Invalid Temporal attribute 'keepalive_permit_without_stream'",
+			"Temporal attribute 'keepalive_permit_without_stream' requires keepalive pings, enabled by setting 'keepalive_time' or 'keepalive_timeout'",
+		)
+	}
+	return connectionOptions
+}
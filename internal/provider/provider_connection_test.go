@@ -0,0 +1,85 @@
+package provider
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestBuildConnectionOptions(t *testing.T) {
+	testCases := map[string]struct {
+		config               TemporalProviderModel
+		maxPayloadSize       int
+		keepAliveTime        time.Duration
+		keepAliveTimeout     time.Duration
+		enableKeepAlive      bool
+		permitWithoutStream  bool
+		expectErrorAttribute string
+	}{
+		"defaults": {},
+		"max payload size": {
+			config:         TemporalProviderModel{MaxPayloadSize: types.Int64Value(64 << 20)},
+			maxPayloadSize: 64 << 20,
+		},
+		"zero max payload size": {
+			config:               TemporalProviderModel{MaxPayloadSize: types.Int64Value(0)},
+			expectErrorAttribute: "max_payload_size",
+		},
+		"oversized max payload size": {
+			config:               TemporalProviderModel{MaxPayloadSize: types.Int64Value(math.MaxInt32 + 1)},
+			expectErrorAttribute: "max_payload_size",
+		},
+		"keepalive time": {
+			config:          TemporalProviderModel{KeepaliveTime: types.StringValue("30s")},
+			keepAliveTime:   30 * time.Second,
+			enableKeepAlive: true,
+		},
+		"keepalive timeout with permit without stream": {
+			config: TemporalProviderModel{
+				KeepaliveTimeout: types.StringValue("15s"),
+				KeepalivePermit:  types.BoolValue(true),
+			},
+			keepAliveTimeout:    15 * time.Second,
+			enableKeepAlive:     true,
+			permitWithoutStream: true,
+		},
+		"permit without stream alone": {
+			config:               TemporalProviderModel{KeepalivePermit: types.BoolValue(true)},
+			permitWithoutStream:  true,
+			expectErrorAttribute: "keepalive_permit_without_stream",
+		},
+		"invalid keepalive time": {
+			config:               TemporalProviderModel{KeepaliveTime: types.StringValue("often")},
+			expectErrorAttribute: "keepalive_time",
+		},
+	}
+
+	for name, testCase := range testCases {
+		var diags diag.Diagnostics
+		options := buildConnectionOptions(&testCase.config, &diags)
+		if testCase.expectErrorAttribute != "" {
+			if diags.ErrorsCount() != 1 {
+				t.Errorf("%s: expected a single error, got %v", name, diags)
+				continue
+			}
+			if attributeError, ok := diags.Errors()[0].(diag.DiagnosticWithPath); !ok || attributeError.Path().String() != testCase.expectErrorAttribute {
+				t.Errorf("%s: expected error on %q, got %v", name, testCase.expectErrorAttribute, diags.Errors()[0])
+			}
+			continue
+		}
+		if diags.HasError() {
+			t.Errorf("%s: unexpected diagnostics: %v", name, diags)
+			continue
+		}
+		if options.MaxPayloadSize != testCase.maxPayloadSize ||
+			options.KeepAliveTime != testCase.keepAliveTime ||
+			options.KeepAliveTimeout != testCase.keepAliveTimeout ||
+			options.EnableKeepAliveCheck != testCase.enableKeepAlive ||
+			options.KeepAlivePermitWithoutStream != testCase.permitWithoutStream {
+			t.Errorf("%s: unexpected connection options %+v", name, options)
+		}
+	}
+}
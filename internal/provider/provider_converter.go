@@ -0,0 +1,131 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/sdk/converter"
+)
+
+// defaultPayloadConverters returns the payload converters of the Temporal SDK's
+// default data converter, in their default order.
+func defaultPayloadConverters() []converter.PayloadConverter {
+	return []converter.PayloadConverter{
+		converter.NewNilPayloadConverter(),
+		converter.NewByteSlicePayloadConverter(),
+		converter.NewProtoJSONPayloadConverter(),
+		converter.NewJSONPayloadConverter(),
+	}
+}
+
+// dataConverterEncodings returns the encodings accepted by buildDataConverter.
+func dataConverterEncodings() []string {
+	var encodings []string
+	for _, payloadConverter := range defaultPayloadConverters() {
+		encodings = append(encodings, payloadConverter.Encoding())
+	}
+	return encodings
+}
+
+// buildDataConverter creates a data converter that tries the payload converter for
+// the preferred encoding first, then the rest of the SDK's default converters.
+// An empty encoding keeps the SDK's default order.  Either way, arguments
+// already encoded by encodeArgument are passed through unchanged.
+func buildDataConverter(encoding string) (converter.DataConverter, error) {
+	payloadConverters := defaultPayloadConverters()
+	if encoding == "" {
+		return converter.NewCompositeDataConverter(append([]converter.PayloadConverter{encodedArgumentConverter{}}, payloadConverters...)...), nil
+	}
+
+	for i, payloadConverter := range payloadConverters {
+		if payloadConverter.Encoding() != encoding {
+			continue
+		}
+		ordered := []converter.PayloadConverter{encodedArgumentConverter{}, payloadConverter}
+		ordered = append(ordered, payloadConverters[:i]...)
+		ordered = append(ordered, payloadConverters[i+1:]...)
+		return converter.NewCompositeDataConverter(ordered...), nil
+	}
+	return nil, fmt.Errorf("unsupported encoding '%s', must be one of: %s", encoding, strings.Join(dataConverterEncodings(), ", "))
+}
+
+// encodedArgument is an argument already converted to a payload with its own encoding,
+// overriding the provider's data converter.
+type encodedArgument struct {
+	payload *commonpb.Payload
+}
+
+// encodeArgument converts value to an argument of the given encoding, or leaves it to the
+// provider's data converter if encoding is empty.  JSON encodings send value as is,
+// so it must be JSON text; `binary/plain` sends its bytes; `binary/null` requires it to be empty.
+func encodeArgument(value string, encoding string) (interface{}, error) {
+	var payload *commonpb.Payload
+	var err error
+	switch encoding {
+	case "":
+		return value, nil
+	case converter.MetadataEncodingJSON, converter.MetadataEncodingProtoJSON:
+		if !json.Valid([]byte(value)) {
+			return nil, fmt.Errorf("value must be JSON text with encoding '%s'", encoding)
+		}
+		payload = &commonpb.Payload{
+			Metadata: map[string][]byte{converter.MetadataEncoding: []byte(encoding)},
+			Data:     []byte(value),
+		}
+	case converter.MetadataEncodingBinary:
+		payload, err = converter.NewByteSlicePayloadConverter().ToPayload([]byte(value))
+	case converter.MetadataEncodingNil:
+		if value != "" {
+			return nil, fmt.Errorf("value must not be set with encoding '%s'", encoding)
+		}
+		payload, err = converter.NewNilPayloadConverter().ToPayload(nil)
+	default:
+		return nil, fmt.Errorf("unsupported encoding '%s', must be one of: %s", encoding, strings.Join(dataConverterEncodings(), ", "))
+	}
+	if err != nil {
+		return nil, err
+	}
+	return encodedArgument{payload: payload}, nil
+}
+
+// encodedArgumentConverter passes the payloads of encoded arguments through the data converter,
+// so that codecs wrapping it, like encryption, still encode them.
+type encodedArgumentConverter struct{}
+
+func (encodedArgumentConverter) ToPayload(value interface{}) (*commonpb.Payload, error) {
+	if argument, ok := value.(encodedArgument); ok {
+		return argument.payload, nil
+	}
+	return nil, nil
+}
+
+func (encodedArgumentConverter) FromPayload(payload *commonpb.Payload, valuePtr interface{}) error {
+	return fmt.Errorf("encoded arguments can only be converted to payloads")
+}
+
+func (encodedArgumentConverter) ToString(payload *commonpb.Payload) string {
+	return ""
+}
+
+// Encoding is never set on payloads, which keep the encoding of the argument.
+func (encodedArgumentConverter) Encoding() string {
+	return "terraform/encoded-argument"
+}
+
+// buildCodecDataConverter wraps parent to encode and decode payloads with the remote
+// codec server at endpoint, as used by the Temporal UI and CLI.  If auth is set,
+// it is sent as the Authorization header of every codec request.
+func buildCodecDataConverter(parent converter.DataConverter, endpoint string, auth string) converter.DataConverter {
+	return converter.NewRemoteDataConverter(parent, converter.RemoteDataConverterOptions{
+		Endpoint: endpoint,
+		ModifyRequest: func(req *http.Request) error {
+			if auth != "" {
+				req.Header.Set("Authorization", auth)
+			}
+			return nil
+		},
+	})
+}
@@ -0,0 +1,151 @@
+package provider
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/sdk/converter"
+
+	temporalClient "go.temporal.io/sdk/client"
+)
+
+func TestBuildDataConverter(t *testing.T) {
+	testCases := map[string]struct {
+		value            interface{}
+		expectedEncoding string
+	}{
+		"":             {value: []byte("raw"), expectedEncoding: converter.MetadataEncodingBinary},
+		"json/plain":   {value: []byte("raw"), expectedEncoding: converter.MetadataEncodingJSON},
+		"binary/plain": {value: []byte("raw"), expectedEncoding: converter.MetadataEncodingBinary},
+		"binary/null":  {value: nil, expectedEncoding: converter.MetadataEncodingNil},
+		// json/protobuf only applies to protobuf messages
+		"json/protobuf": {value: map[string]string{"a": "b"}, expectedEncoding: converter.MetadataEncodingJSON},
+	}
+
+	for encoding, testCase := range testCases {
+		dataConverter, err := buildDataConverter(encoding)
+		if err != nil {
+			t.Fatalf("%q: unexpected error: %s", encoding, err)
+		}
+		payload, err := dataConverter.ToPayload(testCase.value)
+		if err != nil {
+			t.Fatalf("%q: unable to convert: %s", encoding, err)
+		}
+		if actual := string(payload.Metadata[converter.MetadataEncoding]); actual != testCase.expectedEncoding {
+			t.Errorf("%q: expected encoding %q, got %q", encoding, testCase.expectedEncoding, actual)
+		}
+	}
+
+	if _, err := buildDataConverter("binary/gzip"); err == nil {
+		t.Error("expected error for unsupported encoding")
+	}
+}
+
+func TestBuildCodecDataConverter(t *testing.T) {
+	codecHandler := converter.NewPayloadCodecHTTPHandler(converter.NewZlibCodec(converter.ZlibCodecOptions{AlwaysEncode: true}))
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer codec-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		codecHandler.ServeHTTP(w, r)
+	}))
+	defer server.Close()
+
+	dataConverter := buildCodecDataConverter(converter.GetDefaultDataConverter(), server.URL, "Bearer codec-token")
+	payload, err := dataConverter.ToPayload("hello")
+	if err != nil {
+		t.Fatalf("unable to encode: %s", err)
+	}
+	if encoding := string(payload.Metadata[converter.MetadataEncoding]); encoding != "binary/zlib" {
+		t.Errorf("expected payload to be encoded by the codec server, got encoding %q", encoding)
+	}
+
+	desc := decodeScheduleDescription(&temporalClient.ScheduleDescription{
+		Schedule: temporalClient.Schedule{
+			Action: &temporalClient.ScheduleWorkflowAction{
+				Args: []interface{}{payload},
+				Memo: map[string]interface{}{"owner": payload},
+			},
+		},
+		Memo:             &commonpb.Memo{Fields: map[string]*commonpb.Payload{"owner": payload}},
+		SearchAttributes: &commonpb.SearchAttributes{IndexedFields: map[string]*commonpb.Payload{"team": payload}},
+	}, dataConverter)
+	action, ok := desc.Schedule.Action.(*temporalClient.ScheduleWorkflowAction)
+	if !ok || action.Args[0] != `"hello"` || action.Memo["owner"] != `"hello"` {
+		t.Errorf("expected decoded payloads, got args %v and memo %v", action.Args, action.Memo)
+	}
+	if desc.Memo["owner"] != `"hello"` || desc.SearchAttributes["team"] != `"hello"` {
+		t.Errorf("expected decoded Schedule payloads, got memo %v and search attributes %v", desc.Memo, desc.SearchAttributes)
+	}
+	jsonBytes, err := json.Marshal(desc)
+	if err != nil {
+		t.Fatalf("unable to marshal: %s", err)
+	}
+	if !strings.Contains(string(jsonBytes), `"Memo":{"owner":"\"hello\""}`) || !strings.Contains(string(jsonBytes), `"SearchAttributes":{"team":"\"hello\""}`) {
+		t.Errorf("expected decoded payloads in JSON, got %s", jsonBytes)
+	}
+
+	unauthorized := buildCodecDataConverter(converter.GetDefaultDataConverter(), server.URL, "")
+	if _, err := unauthorized.ToPayload("hello"); err == nil {
+		t.Error("expected error without codec auth")
+	}
+}
+
+func TestEncodeArgument(t *testing.T) {
+	testCases := map[string]struct {
+		value            string
+		encoding         string
+		expectedEncoding string
+		expectedData     string
+		expectError      bool
+	}{
+		"default":             {value: "hello", expectedEncoding: converter.MetadataEncodingJSON, expectedData: `"hello"`},
+		"json/plain":          {value: `{"id": 1}`, encoding: "json/plain", expectedEncoding: converter.MetadataEncodingJSON, expectedData: `{"id": 1}`},
+		"json/plain invalid":  {value: "hello", encoding: "json/plain", expectError: true},
+		"json/protobuf":       {value: `{"seconds": "1"}`, encoding: "json/protobuf", expectedEncoding: converter.MetadataEncodingProtoJSON, expectedData: `{"seconds": "1"}`},
+		"binary/plain":        {value: "raw", encoding: "binary/plain", expectedEncoding: converter.MetadataEncodingBinary, expectedData: "raw"},
+		"binary/null":         {encoding: "binary/null", expectedEncoding: converter.MetadataEncodingNil},
+		"binary/null invalid": {value: "hello", encoding: "binary/null", expectError: true},
+		"unsupported":         {value: "hello", encoding: "binary/gzip", expectError: true},
+	}
+
+	// Encoded arguments still go through the codecs wrapping the data converter
+	dataConverter, err := buildDataConverter("")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	dataConverter = converter.NewCodecDataConverter(dataConverter, converter.NewZlibCodec(converter.ZlibCodecOptions{AlwaysEncode: true}))
+
+	for name, testCase := range testCases {
+		arg, err := encodeArgument(testCase.value, testCase.encoding)
+		if (err != nil) != testCase.expectError {
+			t.Errorf("%s: unexpected error: %v", name, err)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+		payload, err := dataConverter.ToPayload(arg)
+		if err != nil {
+			t.Fatalf("%s: unable to convert: %s", name, err)
+		}
+		if encoding := string(payload.Metadata[converter.MetadataEncoding]); encoding != "binary/zlib" {
+			t.Errorf("%s: expected payload to be encoded by the codec, got encoding %q", name, encoding)
+		}
+		decoded, err := converter.NewZlibCodec(converter.ZlibCodecOptions{}).Decode([]*commonpb.Payload{payload})
+		if err != nil {
+			t.Fatalf("%s: unable to decode: %s", name, err)
+		}
+		if encoding := string(decoded[0].Metadata[converter.MetadataEncoding]); encoding != testCase.expectedEncoding {
+			t.Errorf("%s: expected encoding %q, got %q", name, testCase.expectedEncoding, encoding)
+		}
+		if data := string(decoded[0].Data); data != testCase.expectedData {
+			t.Errorf("%s: expected data %q, got %q", name, testCase.expectedData, data)
+		}
+	}
+}
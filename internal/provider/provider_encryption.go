@@ -0,0 +1,95 @@
+package provider
+
+import (
+	"encoding/base64"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"go.temporal.io/sdk/converter"
+
+	"github.com/neomantra/terraform-provider-temporal/internal/encryption"
+)
+
+// TemporalProviderEncryptionModel describes the provider's `encryption` block.
+type TemporalProviderEncryptionModel struct {
+	KeyID   types.String `tfsdk:"key_id"`
+	Key     types.String `tfsdk:"key"`
+	KeyFile types.String `tfsdk:"key_file"`
+	KeyEnv  types.String `tfsdk:"key_env"`
+}
+
+func providerEncryptionBlock() schema.Block {
+	return schema.SingleNestedBlock{
+		MarkdownDescription: "Encrypts payloads written by the provider with AES-GCM, using the envelope format of the " +
+			"[Temporal encryption sample](https://github.com/temporalio/samples-go/tree/main/encryption). " +
+			"Exactly one of `key`, `key_file` or `key_env` must be set. Cannot be combined with `codec_endpoint`.",
+		Attributes: map[string]schema.Attribute{
+			"key_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the key, recorded in each encrypted payload",
+				Required:            true,
+			},
+			"key": schema.StringAttribute{
+				MarkdownDescription: "Base64-encoded AES key of 16, 24 or 32 bytes",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"key_file": schema.StringAttribute{
+				MarkdownDescription: "Path to a file containing the base64-encoded AES key",
+				Optional:            true,
+			},
+			"key_env": schema.StringAttribute{
+				MarkdownDescription: "Name of an environment variable containing the base64-encoded AES key",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+// buildEncryptionCodec creates the encryption codec from the `encryption` block.
+// Returns nil if the block is absent.
+func buildEncryptionCodec(model *TemporalProviderEncryptionModel) (converter.PayloadCodec, error) {
+	if model == nil {
+		return nil, nil
+	}
+	if isStringUnset(model.KeyID) {
+		return nil, fmt.Errorf("'key_id' must be set")
+	}
+
+	var sources []string
+	var encodedKey string
+	if !isStringUnset(model.Key) {
+		sources = append(sources, "key")
+		encodedKey = model.Key.ValueString()
+	}
+	if !isStringUnset(model.KeyFile) {
+		sources = append(sources, "key_file")
+		data, err := os.ReadFile(model.KeyFile.ValueString())
+		if err != nil {
+			return nil, fmt.Errorf("unable to read 'key_file': %w", err)
+		}
+		encodedKey = string(data)
+	}
+	if !isStringUnset(model.KeyEnv) {
+		sources = append(sources, "key_env")
+		encodedKey = os.Getenv(model.KeyEnv.ValueString())
+		if encodedKey == "" {
+			return nil, fmt.Errorf("environment variable '%s' from 'key_env' is not set", model.KeyEnv.ValueString())
+		}
+	}
+	if len(sources) != 1 {
+		return nil, fmt.Errorf("exactly one of 'key', 'key_file' or 'key_env' must be set")
+	}
+
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(encodedKey))
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode base64 key from '%s': %w", sources[0], err)
+	}
+	codec, err := encryption.NewCodec(model.KeyID.ValueString(), key)
+	if err != nil {
+		return nil, fmt.Errorf("invalid key from '%s': %w", sources[0], err)
+	}
+	return codec, nil
+}
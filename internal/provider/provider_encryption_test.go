@@ -0,0 +1,49 @@
+package provider
+
+import (
+	"bytes"
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestBuildEncryptionCodec(t *testing.T) {
+	encodedKey := base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{0x42}, 32))
+	keyFile := filepath.Join(t.TempDir(), "key")
+	if err := os.WriteFile(keyFile, []byte(encodedKey+"\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("TEST_TEMPORAL_ENCRYPTION_KEY", encodedKey)
+
+	codec, err := buildEncryptionCodec(nil)
+	if err != nil || codec != nil {
+		t.Fatalf("expected no codec for absent block, got %v, %v", codec, err)
+	}
+
+	for name, model := range map[string]TemporalProviderEncryptionModel{
+		"key":      {KeyID: types.StringValue("k1"), Key: types.StringValue(encodedKey)},
+		"key_file": {KeyID: types.StringValue("k1"), KeyFile: types.StringValue(keyFile)},
+		"key_env":  {KeyID: types.StringValue("k1"), KeyEnv: types.StringValue("TEST_TEMPORAL_ENCRYPTION_KEY")},
+	} {
+		model := model
+		if codec, err := buildEncryptionCodec(&model); err != nil || codec == nil {
+			t.Errorf("%s: expected codec, got error: %v", name, err)
+		}
+	}
+
+	for name, model := range map[string]TemporalProviderEncryptionModel{
+		"no key":        {KeyID: types.StringValue("k1")},
+		"two keys":      {KeyID: types.StringValue("k1"), Key: types.StringValue(encodedKey), KeyFile: types.StringValue(keyFile)},
+		"not base64":    {KeyID: types.StringValue("k1"), Key: types.StringValue("not base64!")},
+		"wrong size":    {KeyID: types.StringValue("k1"), Key: types.StringValue(base64.StdEncoding.EncodeToString([]byte("short")))},
+		"unset env var": {KeyID: types.StringValue("k1"), KeyEnv: types.StringValue("TEST_TEMPORAL_ENCRYPTION_UNSET")},
+	} {
+		model := model
+		if _, err := buildEncryptionCodec(&model); err == nil {
+			t.Errorf("%s: expected error", name)
+		}
+	}
+}
@@ -0,0 +1,105 @@
+package provider
+
+import (
+	"os"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Environment variables honored by the provider, matching the Temporal CLI.
+const (
+	envAddress        = "TEMPORAL_ADDRESS"
+	envLegacyAddress  = "TEMPORAL_CLI_ADDRESS"
+	envNamespace      = "TEMPORAL_NAMESPACE"
+	envAPIKey         = "TEMPORAL_API_KEY"
+	envTLSCert        = "TEMPORAL_TLS_CERT"
+	envTLSKey         = "TEMPORAL_TLS_KEY"
+	envTLSCA          = "TEMPORAL_TLS_CA"
+	envTLSServerName  = "TEMPORAL_TLS_SERVER_NAME"
+	envCodecEndpoint  = "TEMPORAL_CODEC_ENDPOINT"
+	envCodecAuth      = "TEMPORAL_CODEC_AUTH"
+	envGrpcMetaPrefix = "TEMPORAL_GRPC_META_"
+)
+
+// applyProviderEnvironment fills the attributes that are not set in the
+// provider configuration from the Temporal CLI environment variables.
+// Explicit attributes always take precedence over the environment.
+func applyProviderEnvironment(model *TemporalProviderModel) {
+	setStringFromEnv(&model.HostPort, envAddress, envLegacyAddress)
+	setStringFromEnv(&model.Namespace, envNamespace)
+	setStringFromEnv(&model.APIKey, envAPIKey)
+	setStringFromEnv(&model.CodecEndpoint, envCodecEndpoint)
+	setStringFromEnv(&model.CodecAuth, envCodecAuth)
+
+	certFile, keyFile, caFile := os.Getenv(envTLSCert), os.Getenv(envTLSKey), os.Getenv(envTLSCA)
+	serverName := os.Getenv(envTLSServerName)
+	if model.TLS == nil && (certFile != "" || keyFile != "" || caFile != "" || serverName != "") {
+		model.TLS = &TemporalProviderTLSModel{}
+	}
+	if model.TLS != nil {
+		if isStringUnset(model.TLS.Cert) {
+			setStringFromEnv(&model.TLS.CertFile, envTLSCert)
+		}
+		if isStringUnset(model.TLS.Key) {
+			setStringFromEnv(&model.TLS.KeyFile, envTLSKey)
+		}
+		if isStringUnset(model.TLS.CA) {
+			setStringFromEnv(&model.TLS.CAFile, envTLSCA)
+		}
+		setStringFromEnv(&model.TLS.ServerName, envTLSServerName)
+	}
+
+	if envMeta := grpcMetaFromEnvironment(); len(envMeta) != 0 {
+		model.GrpcMeta = mergeGrpcMeta(envMeta, model.GrpcMeta)
+	}
+}
+
+// grpcMetaFromEnvironment collects TEMPORAL_GRPC_META_* variables as gRPC metadata.
+// As with the Temporal CLI, TEMPORAL_GRPC_META_X_TENANT=acme becomes `x-tenant: acme`.
+func grpcMetaFromEnvironment() map[string]string {
+	meta := map[string]string{}
+	for _, kv := range os.Environ() {
+		name, value, ok := strings.Cut(kv, "=")
+		if !ok || !strings.HasPrefix(name, envGrpcMetaPrefix) || len(name) == len(envGrpcMetaPrefix) {
+			continue
+		}
+		key := strings.ToLower(strings.ReplaceAll(strings.TrimPrefix(name, envGrpcMetaPrefix), "_", "-"))
+		meta[key] = value
+	}
+	return meta
+}
+
+// mergeGrpcMeta returns base overlaid with the entries of overrides. Keys are lowercased,
+// as gRPC metadata keys are case-insensitive.
+func mergeGrpcMeta(base map[string]string, overrides types.Map) types.Map {
+	elements := map[string]attr.Value{}
+	for k, v := range base {
+		elements[strings.ToLower(k)] = types.StringValue(v)
+	}
+	if !overrides.IsNull() && !overrides.IsUnknown() {
+		for k, v := range overrides.Elements() {
+			elements[strings.ToLower(k)] = v
+		}
+	}
+	return types.MapValueMust(types.StringType, elements)
+}
+
+// setStringFromEnv sets value from the first non-empty environment variable, if value is unset.
+func setStringFromEnv(value *types.String, names ...string) {
+	if !isStringUnset(*value) {
+		return
+	}
+	for _, name := range names {
+		if envValue := os.Getenv(name); envValue != "" {
+			*value = types.StringValue(envValue)
+			return
+		}
+	}
+}
+
+// isStringUnset returns true if value is null, unknown or empty.
+func isStringUnset(value types.String) bool {
+	return value.IsNull() || value.IsUnknown() || value.ValueString() == ""
+}
@@ -0,0 +1,82 @@
+package provider
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestApplyProviderEnvironment(t *testing.T) {
+	t.Setenv(envAddress, "temporal.example.com:7233")
+	t.Setenv(envLegacyAddress, "legacy.example.com:7233")
+	t.Setenv(envNamespace, "env-namespace")
+	t.Setenv(envAPIKey, "env-key")
+	t.Setenv(envTLSCert, "/env/client.pem")
+	t.Setenv(envTLSKey, "/env/client.key")
+	t.Setenv(envTLSServerName, "env.example.com")
+	t.Setenv(envGrpcMetaPrefix+"X_TENANT", "env-tenant")
+	t.Setenv(envGrpcMetaPrefix+"TEAM", "env-team")
+
+	model := TemporalProviderModel{
+		HostPort:  types.StringNull(),
+		Namespace: types.StringValue("explicit-namespace"),
+		APIKey:    types.StringNull(),
+		GrpcMeta: types.MapValueMust(types.StringType, map[string]attr.Value{
+			"Team": types.StringValue("explicit-team"),
+		}),
+		TLS: &TemporalProviderTLSModel{
+			Key: types.StringValue("inline key"),
+		},
+	}
+	applyProviderEnvironment(&model)
+
+	if model.HostPort.ValueString() != "temporal.example.com:7233" {
+		t.Errorf("expected TEMPORAL_ADDRESS to win over the legacy variable, got %q", model.HostPort.ValueString())
+	}
+	if model.Namespace.ValueString() != "explicit-namespace" {
+		t.Errorf("expected explicit namespace to win, got %q", model.Namespace.ValueString())
+	}
+	if model.APIKey.ValueString() != "env-key" {
+		t.Errorf("unexpected api_key %q", model.APIKey.ValueString())
+	}
+	if model.TLS.CertFile.ValueString() != "/env/client.pem" {
+		t.Errorf("unexpected cert_file %q", model.TLS.CertFile.ValueString())
+	}
+	if !model.TLS.KeyFile.IsNull() {
+		t.Errorf("expected inline key to suppress TEMPORAL_TLS_KEY, got %q", model.TLS.KeyFile.ValueString())
+	}
+	if model.TLS.ServerName.ValueString() != "env.example.com" {
+		t.Errorf("unexpected server_name %q", model.TLS.ServerName.ValueString())
+	}
+
+	meta := model.GrpcMeta.Elements()
+	if len(meta) != 2 || meta["x-tenant"] != types.StringValue("env-tenant") || meta["team"] != types.StringValue("explicit-team") {
+		t.Errorf("unexpected grpc_meta %v", meta)
+	}
+}
+
+func TestApplyProviderEnvironmentTLS(t *testing.T) {
+	for _, name := range []string{envTLSCert, envTLSKey, envTLSCA, envTLSServerName, envAPIKey} {
+		t.Setenv(name, "")
+	}
+	for _, env := range os.Environ() {
+		if name, _, _ := strings.Cut(env, "="); strings.HasPrefix(name, envGrpcMetaPrefix) {
+			t.Setenv(name, "")
+		}
+	}
+
+	model := TemporalProviderModel{}
+	applyProviderEnvironment(&model)
+	if model.TLS != nil {
+		t.Error("expected no tls block without TEMPORAL_TLS_* variables")
+	}
+
+	t.Setenv(envTLSCA, "/env/ca.pem")
+	applyProviderEnvironment(&model)
+	if model.TLS == nil || model.TLS.CAFile.ValueString() != "/env/ca.pem" {
+		t.Error("expected TEMPORAL_TLS_CA to enable TLS")
+	}
+}
@@ -0,0 +1,57 @@
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// providerHeadersProvider implements the Temporal Client's HeadersProvider.
+// It attaches the provider's credentials and gRPC metadata to every gRPC request.
+type providerHeadersProvider struct {
+	apiKey      string
+	tokenSource *oauth2TokenSource
+	grpcMeta    map[string]string
+}
+
+func (h *providerHeadersProvider) GetHeaders(ctx context.Context) (map[string]string, error) {
+	headers := map[string]string{}
+	for k, v := range h.grpcMeta {
+		headers[strings.ToLower(k)] = v
+	}
+	if override, ok := ctx.Value(grpcMetaContextKey{}).(map[string]string); ok {
+		for k, v := range override {
+			headers[strings.ToLower(k)] = v
+		}
+	}
+	if h.apiKey != "" {
+		headers["authorization"] = "Bearer " + h.apiKey
+	}
+	if h.tokenSource != nil {
+		token, err := h.tokenSource.accessToken(ctx)
+		if err != nil {
+			return nil, err
+		}
+		headers["authorization"] = "Bearer " + token
+	}
+	return headers, nil
+}
+
+// grpcMetaContextKey is the context key for per-request gRPC metadata.
+type grpcMetaContextKey struct{}
+
+// contextWithGrpcMeta returns a context whose requests carry the given gRPC metadata,
+// overriding the provider's `grpc_meta` on conflict.  A null or empty map returns ctx unchanged.
+func contextWithGrpcMeta(ctx context.Context, grpcMeta types.Map) (context.Context, diag.Diagnostics) {
+	if grpcMeta.IsNull() || grpcMeta.IsUnknown() || len(grpcMeta.Elements()) == 0 {
+		return ctx, nil
+	}
+	var meta map[string]string
+	diags := grpcMeta.ElementsAs(ctx, &meta, false)
+	if diags.HasError() {
+		return ctx, diags
+	}
+	return context.WithValue(ctx, grpcMetaContextKey{}, meta), diags
+}
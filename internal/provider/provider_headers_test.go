@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestProviderHeadersProvider(t *testing.T) {
+	h := &providerHeadersProvider{
+		apiKey:   "secret",
+		grpcMeta: map[string]string{"X-Tenant": "acme", "team": "core"},
+	}
+
+	headers, err := h.GetHeaders(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	expected := map[string]string{"authorization": "Bearer secret", "x-tenant": "acme", "team": "core"}
+	if len(headers) != len(expected) {
+		t.Fatalf("expected %v, got %v", expected, headers)
+	}
+	for k, v := range expected {
+		if headers[k] != v {
+			t.Errorf("header %q: expected %q, got %q", k, v, headers[k])
+		}
+	}
+
+	override := types.MapValueMust(types.StringType, map[string]attr.Value{"team": types.StringValue("payroll")})
+	ctx, diags := contextWithGrpcMeta(context.Background(), override)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	headers, err = h.GetHeaders(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if headers["team"] != "payroll" || headers["x-tenant"] != "acme" {
+		t.Errorf("expected per-request override to win, got %v", headers)
+	}
+
+	ctx, diags = contextWithGrpcMeta(context.Background(), types.MapNull(types.StringType))
+	if diags.HasError() || ctx != context.Background() {
+		t.Error("expected null grpc_meta to leave the context unchanged")
+	}
+}
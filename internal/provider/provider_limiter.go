@@ -0,0 +1,77 @@
+package provider
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"golang.org/x/time/rate"
+	"google.golang.org/grpc"
+)
+
+// requestLimiter bounds the concurrency and rate of the gRPC requests
+// made through the provider's connection to the Temporal Server.
+type requestLimiter struct {
+	semaphore   chan struct{} // nil if concurrency is unlimited
+	rateLimiter *rate.Limiter // nil if the rate is unlimited
+}
+
+// newRequestLimiter creates a limiter allowing maxConcurrent requests in flight and
+// requestsPerSecond requests per second, where 0 means unlimited.
+// Returns nil if both are unlimited.
+func newRequestLimiter(maxConcurrent int, requestsPerSecond float64) *requestLimiter {
+	if maxConcurrent <= 0 && requestsPerSecond <= 0 {
+		return nil
+	}
+	limiter := &requestLimiter{}
+	if maxConcurrent > 0 {
+		limiter.semaphore = make(chan struct{}, maxConcurrent)
+	}
+	if requestsPerSecond > 0 {
+		burst := int(math.Max(1, math.Ceil(requestsPerSecond)))
+		limiter.rateLimiter = rate.NewLimiter(rate.Limit(requestsPerSecond), burst)
+	}
+	return limiter
+}
+
+// acquire waits for a request slot, returning a function to release it.
+func (l *requestLimiter) acquire(ctx context.Context, method string) (func(), error) {
+	start := time.Now()
+	release := func() {}
+
+	if l.semaphore != nil {
+		select {
+		case l.semaphore <- struct{}{}:
+			release = func() { <-l.semaphore }
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	if l.rateLimiter != nil {
+		if err := l.rateLimiter.Wait(ctx); err != nil {
+			release()
+			return nil, err
+		}
+	}
+
+	if waited := time.Since(start); waited >= time.Millisecond {
+		tflog.Debug(ctx, "Waited for Temporal request limiter", map[string]interface{}{
+			"method": method,
+			"waited": waited.String(),
+		})
+	}
+	return release, nil
+}
+
+// unaryInterceptor returns a gRPC interceptor applying the limiter to every request.
+func (l *requestLimiter) unaryInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		release, err := l.acquire(ctx, method)
+		if err != nil {
+			return err
+		}
+		defer release()
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRequestLimiterConcurrency(t *testing.T) {
+	if newRequestLimiter(0, 0) != nil {
+		t.Fatal("expected no limiter when unlimited")
+	}
+
+	limiter := newRequestLimiter(2, 0)
+	var inFlight, maxInFlight int32
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			release, err := limiter.acquire(context.Background(), "Test")
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			defer release()
+			current := atomic.AddInt32(&inFlight, 1)
+			for {
+				highest := atomic.LoadInt32(&maxInFlight)
+				if current <= highest || atomic.CompareAndSwapInt32(&maxInFlight, highest, current) {
+					break
+				}
+			}
+			time.Sleep(5 * time.Millisecond)
+			atomic.AddInt32(&inFlight, -1)
+		}()
+	}
+	wg.Wait()
+	if maxInFlight > 2 {
+		t.Errorf("expected at most 2 requests in flight, got %d", maxInFlight)
+	}
+}
+
+func TestRequestLimiterRate(t *testing.T) {
+	limiter := newRequestLimiter(0, 100)
+
+	start := time.Now()
+	for i := 0; i < 110; i++ {
+		release, err := limiter.acquire(context.Background(), "Test")
+		if err != nil {
+			t.Fatal(err)
+		}
+		release()
+	}
+	// the first 100 requests use the burst, the next 10 wait 10ms each
+	if elapsed := time.Since(start); elapsed < 80*time.Millisecond {
+		t.Errorf("expected requests to be rate limited, took %s", elapsed)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := newRequestLimiter(1, 0.001).acquire(ctx, "Test"); err == nil {
+		t.Error("expected error for canceled context")
+	}
+}
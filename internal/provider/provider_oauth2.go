@@ -0,0 +1,193 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// oauth2ExpiryDelta is how long before its expiry a token is refreshed,
+// so that requests never carry a token that expires in flight.
+const oauth2ExpiryDelta = 1 * time.Minute
+
+// TemporalProviderOAuth2Model describes the provider's `oauth2` block.
+type TemporalProviderOAuth2Model struct {
+	TokenURL     types.String `tfsdk:"token_url"`
+	ClientID     types.String `tfsdk:"client_id"`
+	ClientSecret types.String `tfsdk:"client_secret"`
+	Scopes       types.List   `tfsdk:"scopes"`
+	Audience     types.String `tfsdk:"audience"`
+}
+
+func providerOAuth2Block() schema.Block {
+	return schema.SingleNestedBlock{
+		MarkdownDescription: "Authenticates with access tokens from an OAuth2 client credentials grant, sent as a bearer token on every request. " +
+			"Tokens are cached and refreshed before they expire. Enables TLS if no `tls` block is set. Conflicts with `api_key`.",
+		Attributes: map[string]schema.Attribute{
+			"token_url": schema.StringAttribute{
+				MarkdownDescription: "URL of the token endpoint of the authorization server, which must use `https` unless it is on a loopback host such as `localhost`",
+				Required:            true,
+			},
+			"client_id": schema.StringAttribute{
+				MarkdownDescription: "OAuth2 client ID",
+				Required:            true,
+			},
+			"client_secret": schema.StringAttribute{
+				MarkdownDescription: "OAuth2 client secret",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"scopes": schema.ListAttribute{
+				MarkdownDescription: "Scopes to request",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"audience": schema.StringAttribute{
+				MarkdownDescription: "Audience to request, as required by some authorization servers such as Auth0",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+// oauth2TokenSource fetches and caches access tokens with the OAuth2 client credentials grant.
+type oauth2TokenSource struct {
+	tokenURL     string
+	clientID     string
+	clientSecret string
+	scopes       []string
+	audience     string
+	httpClient   *http.Client
+	now          func() time.Time
+
+	mu     sync.Mutex
+	token  string
+	expiry time.Time // zero if the token does not expire
+}
+
+// newOAuth2TokenSource creates the token source from the `oauth2` block.
+// Returns nil if the block is absent.
+func newOAuth2TokenSource(ctx context.Context, model *TemporalProviderOAuth2Model) (*oauth2TokenSource, error) {
+	if model == nil {
+		return nil, nil
+	}
+	if isStringUnset(model.TokenURL) || isStringUnset(model.ClientID) {
+		return nil, fmt.Errorf("'token_url' and 'client_id' must be set")
+	}
+	tokenURL, err := url.Parse(model.TokenURL.ValueString())
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse 'token_url': %w", err)
+	}
+	// The client secret is sent to the token endpoint, so plain http is only allowed on this machine
+	if tokenURL.Scheme != "https" && !(tokenURL.Scheme == "http" && isLoopbackHost(tokenURL.Hostname())) {
+		return nil, fmt.Errorf("'token_url' must be an https URL, or an http URL on a loopback host, got '%s'", model.TokenURL.ValueString())
+	}
+
+	var scopes []string
+	if !model.Scopes.IsNull() && !model.Scopes.IsUnknown() {
+		if diags := model.Scopes.ElementsAs(ctx, &scopes, false); diags.HasError() {
+			return nil, fmt.Errorf("unable to read 'scopes'")
+		}
+	}
+
+	return &oauth2TokenSource{
+		tokenURL:     tokenURL.String(),
+		clientID:     model.ClientID.ValueString(),
+		clientSecret: model.ClientSecret.ValueString(),
+		scopes:       scopes,
+		audience:     model.Audience.ValueString(),
+		httpClient:   &http.Client{Timeout: 30 * time.Second},
+		now:          time.Now,
+	}, nil
+}
+
+// isLoopbackHost returns true if host is localhost or a loopback IP address.
+func isLoopbackHost(host string) bool {
+	if strings.EqualFold(host, "localhost") {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+// oauth2TokenResponse is the successful response of a token endpoint, per RFC 6749 section 5.1.
+type oauth2TokenResponse struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	ExpiresIn   int64  `json:"expires_in"`
+}
+
+// accessToken returns the cached access token, fetching a new one if it is missing or about to expire.
+func (s *oauth2TokenSource) accessToken(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.token != "" && (s.expiry.IsZero() || s.now().Add(oauth2ExpiryDelta).Before(s.expiry)) {
+		return s.token, nil
+	}
+
+	token, err := s.fetch(ctx)
+	if err != nil {
+		return "", err
+	}
+	s.token = token.AccessToken
+	s.expiry = time.Time{}
+	if token.ExpiresIn > 0 {
+		s.expiry = s.now().Add(time.Duration(token.ExpiresIn) * time.Second)
+	}
+	return s.token, nil
+}
+
+// fetch requests a new access token from the token endpoint.
+func (s *oauth2TokenSource) fetch(ctx context.Context) (*oauth2TokenResponse, error) {
+	form := url.Values{"grant_type": {"client_credentials"}}
+	if len(s.scopes) != 0 {
+		form.Set("scope", strings.Join(s.scopes, " "))
+	}
+	if s.audience != "" {
+		form.Set("audience", s.audience)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.tokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	// Client credentials are form-encoded before basic authentication, per RFC 6749 section 2.3.1
+	req.SetBasicAuth(url.QueryEscape(s.clientID), url.QueryEscape(s.clientSecret))
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch OAuth2 token: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return nil, fmt.Errorf("unable to read OAuth2 token response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("OAuth2 token endpoint returned %s: %s", resp.Status, strings.TrimSpace(string(body)))
+	}
+
+	var token oauth2TokenResponse
+	if err := json.Unmarshal(body, &token); err != nil {
+		return nil, fmt.Errorf("unable to parse OAuth2 token response: %w", err)
+	}
+	if token.AccessToken == "" {
+		return nil, fmt.Errorf("OAuth2 token response has no access_token")
+	}
+	if token.TokenType != "" && !strings.EqualFold(token.TokenType, "bearer") {
+		return nil, fmt.Errorf("unsupported OAuth2 token type '%s'", token.TokenType)
+	}
+	return &token, nil
+}
@@ -0,0 +1,105 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestOAuth2TokenSource(t *testing.T) {
+	var requests int
+	tokenServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		clientID, clientSecret, ok := r.BasicAuth()
+		if !ok || clientID != "terraform" || clientSecret != "s3cret" {
+			http.Error(w, `{"error":"invalid_client"}`, http.StatusUnauthorized)
+			return
+		}
+		if r.PostFormValue("grant_type") != "client_credentials" ||
+			r.PostFormValue("scope") != "temporal:read temporal:write" ||
+			r.PostFormValue("audience") != "https://temporal.example.com" {
+			http.Error(w, `{"error":"invalid_request"}`, http.StatusBadRequest)
+			return
+		}
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"access_token":"token-%d","token_type":"Bearer","expires_in":3600}`, requests)
+	}))
+	defer tokenServer.Close()
+
+	model := &TemporalProviderOAuth2Model{
+		TokenURL:     types.StringValue(tokenServer.URL),
+		ClientID:     types.StringValue("terraform"),
+		ClientSecret: types.StringValue("s3cret"),
+		Scopes: types.ListValueMust(types.StringType, []attr.Value{
+			types.StringValue("temporal:read"),
+			types.StringValue("temporal:write"),
+		}),
+		Audience: types.StringValue("https://temporal.example.com"),
+	}
+	tokenSource, err := newOAuth2TokenSource(context.Background(), model)
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now()
+	tokenSource.now = func() time.Time { return now }
+
+	headersProvider := &providerHeadersProvider{tokenSource: tokenSource}
+	for i := 0; i < 2; i++ {
+		headers, err := headersProvider.GetHeaders(context.Background())
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if headers["authorization"] != "Bearer token-1" {
+			t.Errorf("expected cached token, got %q", headers["authorization"])
+		}
+	}
+
+	// Tokens are refreshed shortly before they expire
+	now = now.Add(3600*time.Second - oauth2ExpiryDelta/2)
+	headers, err := headersProvider.GetHeaders(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if headers["authorization"] != "Bearer token-2" {
+		t.Errorf("expected refreshed token, got %q", headers["authorization"])
+	}
+
+	model.ClientSecret = types.StringValue("wrong")
+	badSource, err := newOAuth2TokenSource(context.Background(), model)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := badSource.accessToken(context.Background()); err == nil {
+		t.Error("expected error for rejected client credentials")
+	}
+}
+
+func TestNewOAuth2TokenSource(t *testing.T) {
+	if tokenSource, err := newOAuth2TokenSource(context.Background(), nil); err != nil || tokenSource != nil {
+		t.Errorf("expected no token source without a block, got %v, %v", tokenSource, err)
+	}
+	invalid := []*TemporalProviderOAuth2Model{
+		{TokenURL: types.StringValue("https://auth.example.com/token")},
+		{TokenURL: types.StringValue("ftp://auth.example.com/token"), ClientID: types.StringValue("terraform")},
+		{TokenURL: types.StringValue("http://auth.example.com/token"), ClientID: types.StringValue("terraform")},
+		{TokenURL: types.StringValue("http://10.0.0.1/token"), ClientID: types.StringValue("terraform")},
+	}
+	for _, model := range invalid {
+		if _, err := newOAuth2TokenSource(context.Background(), model); err == nil {
+			t.Errorf("expected error for %v", model)
+		}
+	}
+	valid := []string{"https://auth.example.com/token", "http://localhost:8080/token", "http://127.0.0.1:8080/token", "http://[::1]/token"}
+	for _, tokenURL := range valid {
+		model := &TemporalProviderOAuth2Model{TokenURL: types.StringValue(tokenURL), ClientID: types.StringValue("terraform")}
+		if _, err := newOAuth2TokenSource(context.Background(), model); err != nil {
+			t.Errorf("unexpected error for %s: %s", tokenURL, err)
+		}
+	}
+}
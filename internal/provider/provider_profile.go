@@ -0,0 +1,156 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/BurntSushi/toml"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Environment variables selecting the Temporal CLI client config file and profile.
+const (
+	envConfigFile = "TEMPORAL_CONFIG_FILE"
+	envProfile    = "TEMPORAL_PROFILE"
+)
+
+const defaultProfileName = "default"
+
+// clientConfigFile is the Temporal CLI client config file,
+// by default at `<user config dir>/temporalio/temporal.toml`.
+type clientConfigFile struct {
+	Profiles map[string]clientConfigProfile `toml:"profile"`
+}
+
+// clientConfigProfile is a named connection profile in a clientConfigFile.
+type clientConfigProfile struct {
+	Address   string                    `toml:"address"`
+	Namespace string                    `toml:"namespace"`
+	APIKey    string                    `toml:"api_key"`
+	TLS       *clientConfigProfileTLS   `toml:"tls"`
+	Codec     *clientConfigProfileCodec `toml:"codec"`
+	GrpcMeta  map[string]string         `toml:"grpc_meta"`
+}
+
+type clientConfigProfileCodec struct {
+	Endpoint string `toml:"endpoint"`
+	Auth     string `toml:"auth"`
+}
+
+type clientConfigProfileTLS struct {
+	Disabled                bool   `toml:"disabled"`
+	ClientCertPath          string `toml:"client_cert_path"`
+	ClientCertData          string `toml:"client_cert_data"`
+	ClientKeyPath           string `toml:"client_key_path"`
+	ClientKeyData           string `toml:"client_key_data"`
+	ServerCACertPath        string `toml:"server_ca_cert_path"`
+	ServerCACertData        string `toml:"server_ca_cert_data"`
+	ServerName              string `toml:"server_name"`
+	DisableHostVerification bool   `toml:"disable_host_verification"`
+}
+
+// defaultClientConfigFilePath returns the Temporal CLI's default client config file path.
+func defaultClientConfigFilePath() (string, error) {
+	configDir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(configDir, "temporalio", "temporal.toml"), nil
+}
+
+// loadClientConfigProfile loads the named profile from the client config file at filePath.
+// If explicit is false, a missing file or a missing "default" profile is not an error
+// and returns a nil profile.
+func loadClientConfigProfile(filePath string, profileName string, explicit bool) (*clientConfigProfile, error) {
+	var configFile clientConfigFile
+	if _, err := toml.DecodeFile(filePath, &configFile); err != nil {
+		if !explicit && errors.Is(err, fs.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("unable to load config file '%s': %w", filePath, err)
+	}
+
+	profile, ok := configFile.Profiles[profileName]
+	if !ok {
+		if !explicit {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("profile '%s' not found in config file '%s'", profileName, filePath)
+	}
+	return &profile, nil
+}
+
+// applyProviderProfile fills the attributes that are not set in the provider configuration,
+// nor by the environment, from the selected Temporal CLI client config profile.
+func applyProviderProfile(model *TemporalProviderModel) error {
+	setStringFromEnv(&model.ConfigFile, envConfigFile)
+	setStringFromEnv(&model.Profile, envProfile)
+
+	explicit := !isStringUnset(model.ConfigFile) || !isStringUnset(model.Profile)
+	filePath := model.ConfigFile.ValueString()
+	if filePath == "" {
+		var err error
+		if filePath, err = defaultClientConfigFilePath(); err != nil {
+			if !explicit {
+				return nil
+			}
+			return fmt.Errorf("unable to determine default config file: %w", err)
+		}
+	}
+	profileName := model.Profile.ValueString()
+	if profileName == "" {
+		profileName = defaultProfileName
+	}
+
+	profile, err := loadClientConfigProfile(filePath, profileName, explicit)
+	if err != nil || profile == nil {
+		return err
+	}
+
+	setStringFromProfile(&model.HostPort, profile.Address)
+	setStringFromProfile(&model.Namespace, profile.Namespace)
+	setStringFromProfile(&model.APIKey, profile.APIKey)
+
+	if profile.TLS != nil && !profile.TLS.Disabled {
+		if model.TLS == nil {
+			model.TLS = &TemporalProviderTLSModel{}
+		}
+		if isStringUnset(model.TLS.Cert) && isStringUnset(model.TLS.CertFile) {
+			setStringFromProfile(&model.TLS.Cert, profile.TLS.ClientCertData)
+			setStringFromProfile(&model.TLS.CertFile, profile.TLS.ClientCertPath)
+		}
+		if isStringUnset(model.TLS.Key) && isStringUnset(model.TLS.KeyFile) {
+			setStringFromProfile(&model.TLS.Key, profile.TLS.ClientKeyData)
+			setStringFromProfile(&model.TLS.KeyFile, profile.TLS.ClientKeyPath)
+		}
+		if isStringUnset(model.TLS.CA) && isStringUnset(model.TLS.CAFile) {
+			setStringFromProfile(&model.TLS.CA, profile.TLS.ServerCACertData)
+			setStringFromProfile(&model.TLS.CAFile, profile.TLS.ServerCACertPath)
+		}
+		setStringFromProfile(&model.TLS.ServerName, profile.TLS.ServerName)
+		if model.TLS.InsecureSkipVerify.IsNull() && profile.TLS.DisableHostVerification {
+			model.TLS.InsecureSkipVerify = types.BoolValue(true)
+		}
+	}
+
+	if profile.Codec != nil {
+		setStringFromProfile(&model.CodecEndpoint, profile.Codec.Endpoint)
+		setStringFromProfile(&model.CodecAuth, profile.Codec.Auth)
+	}
+
+	if len(profile.GrpcMeta) != 0 {
+		model.GrpcMeta = mergeGrpcMeta(profile.GrpcMeta, model.GrpcMeta)
+	}
+
+	return nil
+}
+
+// setStringFromProfile sets value from the profile, if value is unset.
+func setStringFromProfile(value *types.String, profileValue string) {
+	if isStringUnset(*value) && profileValue != "" {
+		*value = types.StringValue(profileValue)
+	}
+}
@@ -0,0 +1,85 @@
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const testClientConfigFile = `
+[profile.default]
+address = "localhost:7233"
+
+[profile.prod]
+address = "prod.example.com:7233"
+namespace = "prod-namespace"
+api_key = "prod-key"
+
+[profile.prod.tls]
+server_name = "prod.internal"
+server_ca_cert_path = "/etc/temporal/ca.pem"
+
+[profile.prod.grpc_meta]
+X-Tenant = "prod-tenant"
+`
+
+func TestApplyProviderProfile(t *testing.T) {
+	configFile := filepath.Join(t.TempDir(), "temporal.toml")
+	if err := os.WriteFile(configFile, []byte(testClientConfigFile), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	model := TemporalProviderModel{
+		ConfigFile: types.StringValue(configFile),
+		Profile:    types.StringValue("prod"),
+		Namespace:  types.StringValue("explicit-namespace"),
+	}
+	if err := applyProviderProfile(&model); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if model.HostPort.ValueString() != "prod.example.com:7233" {
+		t.Errorf("unexpected hostport %q", model.HostPort.ValueString())
+	}
+	if model.Namespace.ValueString() != "explicit-namespace" {
+		t.Errorf("expected explicit namespace to win, got %q", model.Namespace.ValueString())
+	}
+	if model.APIKey.ValueString() != "prod-key" {
+		t.Errorf("unexpected api_key %q", model.APIKey.ValueString())
+	}
+	if model.TLS == nil || model.TLS.ServerName.ValueString() != "prod.internal" || model.TLS.CAFile.ValueString() != "/etc/temporal/ca.pem" {
+		t.Errorf("unexpected tls %+v", model.TLS)
+	}
+	if meta := model.GrpcMeta.Elements(); meta["x-tenant"] != types.StringValue("prod-tenant") {
+		t.Errorf("unexpected grpc_meta %v", meta)
+	}
+
+	model = TemporalProviderModel{
+		ConfigFile: types.StringValue(configFile),
+		Profile:    types.StringValue("staging"),
+	}
+	if err := applyProviderProfile(&model); err == nil {
+		t.Error("expected error for missing profile")
+	}
+
+	model = TemporalProviderModel{ConfigFile: types.StringValue(filepath.Join(t.TempDir(), "missing.toml"))}
+	if err := applyProviderProfile(&model); err == nil {
+		t.Error("expected error for missing explicit config file")
+	}
+}
+
+func TestApplyProviderProfileDefault(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv(envConfigFile, "")
+	t.Setenv(envProfile, "")
+
+	model := TemporalProviderModel{}
+	if err := applyProviderProfile(&model); err != nil {
+		t.Fatalf("expected a missing default config file to be ignored, got: %s", err)
+	}
+	if !model.HostPort.IsNull() {
+		t.Errorf("unexpected hostport %q", model.HostPort.ValueString())
+	}
+}
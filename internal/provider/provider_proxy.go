@@ -0,0 +1,129 @@
+package provider
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+
+	"golang.org/x/net/http/httpproxy"
+)
+
+// proxyDialer dials the Temporal Server through an HTTP CONNECT proxy.
+// Addresses excluded by NO_PROXY are dialed directly.
+type proxyDialer struct {
+	proxyFunc func(*url.URL) (*url.URL, error)
+	dialer    net.Dialer
+}
+
+// newProxyDialer creates a proxyDialer for proxyURL, or HTTPS_PROXY if proxyURL is empty.
+// Returns nil if no proxy is configured.
+func newProxyDialer(proxyURL string) (*proxyDialer, error) {
+	config := httpproxy.FromEnvironment()
+	if proxyURL != "" {
+		parsed, err := url.Parse(proxyURL)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse proxy URL: %w", err)
+		}
+		if parsed.Scheme != "http" && parsed.Scheme != "https" {
+			return nil, fmt.Errorf("unsupported proxy URL scheme '%s', must be 'http' or 'https'", parsed.Scheme)
+		}
+		config.HTTPSProxy = proxyURL
+	}
+	if config.HTTPSProxy == "" {
+		return nil, nil
+	}
+	return &proxyDialer{proxyFunc: config.ProxyFunc()}, nil
+}
+
+// proxyForAddress returns the proxy to dial addr through, or nil to dial it directly.
+func (d *proxyDialer) proxyForAddress(addr string) (*url.URL, error) {
+	return d.proxyFunc(&url.URL{Scheme: "https", Host: addr})
+}
+
+// DialContext dials addr, through the proxy if one applies. It is used as a gRPC context dialer.
+func (d *proxyDialer) DialContext(ctx context.Context, addr string) (net.Conn, error) {
+	proxyURL, err := d.proxyForAddress(addr)
+	if err != nil {
+		return nil, err
+	}
+	if proxyURL == nil {
+		return d.dialer.DialContext(ctx, "tcp", addr)
+	}
+
+	proxyAddr := proxyURL.Host
+	if proxyURL.Port() == "" {
+		if proxyURL.Scheme == "https" {
+			proxyAddr = net.JoinHostPort(proxyURL.Hostname(), "443")
+		} else {
+			proxyAddr = net.JoinHostPort(proxyURL.Hostname(), "80")
+		}
+	}
+	conn, err := d.dialer.DialContext(ctx, "tcp", proxyAddr)
+	if err != nil {
+		return nil, fmt.Errorf("unable to dial proxy '%s': %w", proxyAddr, err)
+	}
+	if proxyURL.Scheme == "https" {
+		conn = tls.Client(conn, &tls.Config{ServerName: proxyURL.Hostname(), MinVersion: tls.VersionTLS12})
+	}
+
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+	proxyConn, err := connectThroughProxy(conn, proxyURL, addr)
+	if err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+	_ = conn.SetDeadline(time.Time{})
+	return proxyConn, nil
+}
+
+// connectThroughProxy issues an HTTP CONNECT for addr on conn, which is connected to the proxy.
+func connectThroughProxy(conn net.Conn, proxyURL *url.URL, addr string) (net.Conn, error) {
+	req := &http.Request{
+		Method: http.MethodConnect,
+		URL:    &url.URL{Opaque: addr},
+		Host:   addr,
+		Header: http.Header{},
+	}
+	if user := proxyURL.User; user != nil {
+		password, _ := user.Password()
+		credentials := base64.StdEncoding.EncodeToString([]byte(user.Username() + ":" + password))
+		req.Header.Set("Proxy-Authorization", "Basic "+credentials)
+	}
+	if err := req.Write(conn); err != nil {
+		return nil, fmt.Errorf("unable to write CONNECT request to proxy: %w", err)
+	}
+
+	reader := bufio.NewReader(conn)
+	resp, err := http.ReadResponse(reader, req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read CONNECT response from proxy: %w", err)
+	}
+	_ = resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("proxy refused CONNECT to '%s': %s", addr, resp.Status)
+	}
+
+	if reader.Buffered() > 0 {
+		// the server spoke first; keep what the reader already consumed
+		return &bufferedConn{Conn: conn, reader: reader}, nil
+	}
+	return conn, nil
+}
+
+// bufferedConn is a net.Conn whose reads drain a bufio.Reader first.
+type bufferedConn struct {
+	net.Conn
+	reader *bufio.Reader
+}
+
+func (c *bufferedConn) Read(b []byte) (int, error) {
+	return c.reader.Read(b)
+}
@@ -0,0 +1,121 @@
+package provider
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"testing"
+)
+
+// startTestListener starts a TCP listener on localhost, serving each connection with handle.
+func startTestListener(t *testing.T, handle func(net.Conn)) net.Listener {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = listener.Close() })
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go handle(conn)
+		}
+	}()
+	return listener
+}
+
+func TestProxyDialer(t *testing.T) {
+	t.Setenv("HTTPS_PROXY", "")
+	t.Setenv("https_proxy", "")
+	t.Setenv("NO_PROXY", "direct.example.com")
+	t.Setenv("no_proxy", "")
+
+	// echo server standing in for the Temporal Server
+	backend := startTestListener(t, func(conn net.Conn) {
+		defer conn.Close()
+		_, _ = io.Copy(conn, conn)
+	})
+
+	// proxy stand-in, tunneling every CONNECT to the backend
+	connectTargets := make(chan string, 1)
+	proxy := startTestListener(t, func(conn net.Conn) {
+		defer conn.Close()
+		req, err := http.ReadRequest(bufio.NewReader(conn))
+		if err != nil {
+			return
+		}
+		if req.Method != http.MethodConnect || req.Header.Get("Proxy-Authorization") != "Basic dXNlcjpwYXNz" {
+			_, _ = io.WriteString(conn, "HTTP/1.1 407 Proxy Authentication Required\r\n\r\n")
+			return
+		}
+		connectTargets <- req.Host
+		backendConn, err := net.Dial("tcp", backend.Addr().String())
+		if err != nil {
+			return
+		}
+		defer backendConn.Close()
+		_, _ = io.WriteString(conn, "HTTP/1.1 200 Connection established\r\n\r\n")
+		go func() { _, _ = io.Copy(backendConn, conn) }()
+		_, _ = io.Copy(conn, backendConn)
+	})
+
+	dialer, err := newProxyDialer("http://user:pass@" + proxy.Addr().String())
+	if err != nil || dialer == nil {
+		t.Fatalf("expected proxy dialer, got %v, %v", dialer, err)
+	}
+
+	conn, err := dialer.DialContext(context.Background(), "temporal.example.com:7233")
+	if err != nil {
+		t.Fatalf("unable to dial through proxy: %s", err)
+	}
+	defer conn.Close()
+	if target := <-connectTargets; target != "temporal.example.com:7233" {
+		t.Errorf("unexpected CONNECT target %q", target)
+	}
+	if _, err := io.WriteString(conn, "ping"); err != nil {
+		t.Fatal(err)
+	}
+	reply := make([]byte, 4)
+	if _, err := io.ReadFull(conn, reply); err != nil || string(reply) != "ping" {
+		t.Errorf("expected echo through the tunnel, got %q, %v", reply, err)
+	}
+
+	if proxyURL, err := dialer.proxyForAddress("direct.example.com:7233"); err != nil || proxyURL != nil {
+		t.Errorf("expected NO_PROXY address to be dialed directly, got %v, %v", proxyURL, err)
+	}
+
+	badAuth, err := newProxyDialer("http://" + proxy.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := badAuth.DialContext(context.Background(), "temporal.example.com:7233"); err == nil {
+		t.Error("expected error when the proxy refuses CONNECT")
+	}
+}
+
+func TestNewProxyDialer(t *testing.T) {
+	t.Setenv("HTTPS_PROXY", "")
+	t.Setenv("https_proxy", "")
+
+	if dialer, err := newProxyDialer(""); err != nil || dialer != nil {
+		t.Errorf("expected no dialer without a proxy, got %v, %v", dialer, err)
+	}
+	if _, err := newProxyDialer("socks5://proxy:1080"); err == nil {
+		t.Error("expected error for unsupported scheme")
+	}
+
+	t.Setenv("HTTPS_PROXY", "http://proxy.example.com:3128")
+	dialer, err := newProxyDialer("")
+	if err != nil || dialer == nil {
+		t.Fatalf("expected dialer from HTTPS_PROXY, got %v, %v", dialer, err)
+	}
+	proxyURL, err := dialer.proxyForAddress("temporal.example.com:7233")
+	if err != nil || proxyURL == nil || proxyURL.Host != "proxy.example.com:3128" {
+		t.Errorf("unexpected proxy %v, %v", proxyURL, err)
+	}
+}
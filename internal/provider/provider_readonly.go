@@ -0,0 +1,44 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// checkReadOnlyPlan fails the plan of a resource if the provider is read-only
+// and the plan would create, update or delete it.  Mutating resources call it
+// from ModifyPlan, after their own plan modifications.
+func (p *TemporalProviderData) checkReadOnlyPlan(req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse, typeName string) {
+	if p == nil || !p.readOnly {
+		return
+	}
+
+	var action string
+	switch {
+	case req.State.Raw.IsNull():
+		action = "create"
+	case req.Plan.Raw.IsNull():
+		action = "delete"
+	case !resp.Plan.Raw.Equal(req.State.Raw):
+		action = "update"
+	default:
+		return
+	}
+	p.checkReadOnly(action, typeName, &resp.Diagnostics)
+}
+
+// checkReadOnly adds an error to diags and returns true if the provider is read-only.
+// Mutating resources call it first in Create, Update and Delete, in case a plan bypassed ModifyPlan.
+func (p *TemporalProviderData) checkReadOnly(action string, typeName string, diags *diag.Diagnostics) bool {
+	if p == nil || !p.readOnly {
+		return false
+	}
+	diags.AddError(
+		"Temporal provider is read-only",
+		fmt.Sprintf("Unable to %s %s: the provider is configured with `read_only = true`, which refuses all changes to the Temporal Server. "+
+			"Refreshes and data sources still work.", action, typeName),
+	)
+	return true
+}
@@ -0,0 +1,65 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestCheckReadOnlyPlan(t *testing.T) {
+	objectType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{"id": tftypes.String}}
+	null := tftypes.NewValue(objectType, nil)
+	one := tftypes.NewValue(objectType, map[string]tftypes.Value{"id": tftypes.NewValue(tftypes.String, "one")})
+	two := tftypes.NewValue(objectType, map[string]tftypes.Value{"id": tftypes.NewValue(tftypes.String, "two")})
+
+	testCases := map[string]struct {
+		readOnly    bool
+		state       tftypes.Value
+		plan        tftypes.Value
+		expectError bool
+	}{
+		"create":            {readOnly: true, state: null, plan: one, expectError: true},
+		"update":            {readOnly: true, state: one, plan: two, expectError: true},
+		"delete":            {readOnly: true, state: one, plan: null, expectError: true},
+		"refresh":           {readOnly: true, state: one, plan: one},
+		"create read-write": {readOnly: false, state: null, plan: one},
+	}
+
+	for name, testCase := range testCases {
+		req := resource.ModifyPlanRequest{
+			State: tfsdk.State{Raw: testCase.state},
+			Plan:  tfsdk.Plan{Raw: testCase.plan},
+		}
+		resp := &resource.ModifyPlanResponse{Plan: req.Plan}
+		providerData := &TemporalProviderData{readOnly: testCase.readOnly}
+		providerData.checkReadOnlyPlan(req, resp, "temporal_schedule")
+		if resp.Diagnostics.HasError() != testCase.expectError {
+			t.Errorf("%s: expected error %t, got %v", name, testCase.expectError, resp.Diagnostics)
+		}
+	}
+
+	// Unconfigured providers never fail the plan
+	var unconfigured *TemporalProviderData
+	resp := &resource.ModifyPlanResponse{Plan: tfsdk.Plan{Raw: one}}
+	unconfigured.checkReadOnlyPlan(resource.ModifyPlanRequest{State: tfsdk.State{Raw: null}}, resp, "temporal_schedule")
+	if resp.Diagnostics.HasError() {
+		t.Errorf("unexpected error: %v", resp.Diagnostics)
+	}
+}
+
+func TestCheckReadOnly(t *testing.T) {
+	var diags diag.Diagnostics
+	if (&TemporalProviderData{}).checkReadOnly("create", "temporal_schedule", &diags) || diags.HasError() {
+		t.Errorf("expected read-write provider to allow changes, got %v", diags)
+	}
+	var unconfigured *TemporalProviderData
+	if unconfigured.checkReadOnly("create", "temporal_schedule", &diags) || diags.HasError() {
+		t.Errorf("expected unconfigured provider to allow changes, got %v", diags)
+	}
+	if !(&TemporalProviderData{readOnly: true}).checkReadOnly("delete", "temporal_schedule", &diags) || !diags.HasError() {
+		t.Errorf("expected read-only provider to refuse changes, got %v", diags)
+	}
+}
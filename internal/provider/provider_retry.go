@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"go.temporal.io/api/serviceerror"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Defaults for the provider's retry attributes.
+const (
+	defaultRPCTimeout   = 30 * time.Second
+	defaultMaxRetries   = 3
+	defaultRetryBackoff = 1 * time.Second
+)
+
+// retryPolicy bounds and retries the Temporal requests made by resources and data sources.
+type retryPolicy struct {
+	rpcTimeout   time.Duration // per-attempt timeout, 0 for none
+	maxRetries   int           // retries after the first attempt
+	retryBackoff time.Duration // delay before the first retry, doubling each retry
+}
+
+// call invokes fn with a per-attempt timeout.  If idempotent is true, fn is retried
+// with exponential backoff while it fails with a retryable gRPC status code.
+// Calls that are not idempotent, like creating a Schedule, must not be retried.
+func (p retryPolicy) call(ctx context.Context, operation string, idempotent bool, fn func(ctx context.Context) error) error {
+	backoff := p.retryBackoff
+	for attempt := 0; ; attempt++ {
+		err := p.attempt(ctx, fn)
+		if err == nil || !idempotent || attempt >= p.maxRetries || !isRetryableError(err) {
+			return err
+		}
+
+		tflog.Debug(ctx, fmt.Sprintf("%s: retrying after transient Temporal error", operation), map[string]interface{}{
+			"attempt": attempt + 1,
+			"backoff": backoff.String(),
+			"error":   err.Error(),
+		})
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+}
+
+func (p retryPolicy) attempt(ctx context.Context, fn func(ctx context.Context) error) error {
+	if p.rpcTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, p.rpcTimeout)
+		defer cancel()
+	}
+	return fn(ctx)
+}
+
+// isRetryableError returns true if err is a transient gRPC error,
+// such as the frontend restarting or rate limiting.
+func isRetryableError(err error) bool {
+	code := status.Code(err)
+	var serviceErr serviceerror.ServiceError
+	if errors.As(err, &serviceErr) {
+		code = serviceErr.Status().Code()
+	} else if errors.Is(err, context.DeadlineExceeded) {
+		code = codes.DeadlineExceeded
+	}
+
+	switch code {
+	case codes.Unavailable, codes.ResourceExhausted, codes.Aborted, codes.DeadlineExceeded:
+		return true
+	default:
+		return false
+	}
+}
+
+// isNotFoundError returns true if err is a NotFound error from the Temporal Server.
+func isNotFoundError(err error) bool {
+	var notFound *serviceerror.NotFound
+	return errors.As(err, &notFound) || status.Code(err) == codes.NotFound
+}
@@ -0,0 +1,101 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"go.temporal.io/api/serviceerror"
+)
+
+func TestRetryPolicyCall(t *testing.T) {
+	retry := retryPolicy{
+		rpcTimeout:   time.Second,
+		maxRetries:   2,
+		retryBackoff: time.Millisecond,
+	}
+
+	testCases := map[string]struct {
+		idempotent       bool
+		errs             []error
+		expectedAttempts int
+		expectError      bool
+	}{
+		"success": {
+			idempotent:       true,
+			expectedAttempts: 1,
+		},
+		"transient then success": {
+			idempotent:       true,
+			errs:             []error{serviceerror.NewUnavailable("restarting"), serviceerror.NewResourceExhausted(0, "rps")},
+			expectedAttempts: 3,
+		},
+		"retries exhausted": {
+			idempotent:       true,
+			errs:             []error{serviceerror.NewUnavailable("down"), serviceerror.NewUnavailable("down"), serviceerror.NewUnavailable("down")},
+			expectedAttempts: 3,
+			expectError:      true,
+		},
+		"not retryable": {
+			idempotent:       true,
+			errs:             []error{serviceerror.NewNotFound("missing")},
+			expectedAttempts: 1,
+			expectError:      true,
+		},
+		"not idempotent": {
+			idempotent:       false,
+			errs:             []error{serviceerror.NewUnavailable("restarting")},
+			expectedAttempts: 1,
+			expectError:      true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			attempts := 0
+			err := retry.call(context.Background(), "Test", testCase.idempotent, func(ctx context.Context) error {
+				if _, ok := ctx.Deadline(); !ok {
+					t.Error("expected a per-attempt deadline")
+				}
+				attempts++
+				if attempts <= len(testCase.errs) {
+					return testCase.errs[attempts-1]
+				}
+				return nil
+			})
+			if attempts != testCase.expectedAttempts {
+				t.Errorf("expected %d attempts, got %d", testCase.expectedAttempts, attempts)
+			}
+			if (err != nil) != testCase.expectError {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestIsRetryableError(t *testing.T) {
+	if !isRetryableError(context.DeadlineExceeded) {
+		t.Error("expected DeadlineExceeded to be retryable")
+	}
+	if isRetryableError(errors.New("boom")) {
+		t.Error("expected unknown errors not to be retryable")
+	}
+	if isRetryableError(serviceerror.NewInvalidArgument("bad")) {
+		t.Error("expected InvalidArgument not to be retryable")
+	}
+}
+
+func TestIsNotFoundError(t *testing.T) {
+	if !isNotFoundError(serviceerror.NewNotFound("schedule not found")) {
+		t.Error("expected NotFound to be detected")
+	}
+	if !isNotFoundError(fmt.Errorf("describe: %w", serviceerror.NewNotFound("schedule not found"))) {
+		t.Error("expected a wrapped NotFound to be detected")
+	}
+	if isNotFoundError(nil) || isNotFoundError(serviceerror.NewUnavailable("down")) {
+		t.Error("expected other errors not to be NotFound")
+	}
+}
@@ -10,7 +10,7 @@ import (
 const (
 	// providerConfig is a shared configuration to combine with the actual
 	// test configuration so the Temporal Client is properly configured.
-	// It is also possible to use the TEMPORAL_CLI and TEMPORAL_NAMESPACE environment variables instead.
+	// It is also possible to use the TEMPORAL_ADDRESS and TEMPORAL_NAMESPACE environment variables instead.
 	providerConfig = `
 provider "temporal" {
   hostport  = "localhost:7233"
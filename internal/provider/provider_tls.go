@@ -0,0 +1,142 @@
+package provider
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// TemporalProviderTLSModel describes the provider's `tls` block.
+type TemporalProviderTLSModel struct {
+	Cert               types.String `tfsdk:"cert"`
+	CertFile           types.String `tfsdk:"cert_file"`
+	Key                types.String `tfsdk:"key"`
+	KeyFile            types.String `tfsdk:"key_file"`
+	CA                 types.String `tfsdk:"ca"`
+	CAFile             types.String `tfsdk:"ca_file"`
+	ServerName         types.String `tfsdk:"server_name"`
+	InsecureSkipVerify types.Bool   `tfsdk:"insecure_skip_verify"`
+}
+
+func providerTLSBlock() schema.Block {
+	return schema.SingleNestedBlock{
+		MarkdownDescription: "TLS settings for connecting to the Temporal Server. " +
+			"When present, or when any TEMPORAL_TLS_* variable is set, the connection uses TLS; a client certificate and key enable mutual TLS.",
+		Attributes: map[string]schema.Attribute{
+			"cert": schema.StringAttribute{
+				MarkdownDescription: "PEM-encoded client certificate. Conflicts with `cert_file`",
+				Optional:            true,
+			},
+			"cert_file": schema.StringAttribute{
+				MarkdownDescription: "Path to a PEM-encoded client certificate. Conflicts with `cert`. Overrides TEMPORAL_TLS_CERT",
+				Optional:            true,
+			},
+			"key": schema.StringAttribute{
+				MarkdownDescription: "PEM-encoded client private key. Conflicts with `key_file`",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"key_file": schema.StringAttribute{
+				MarkdownDescription: "Path to a PEM-encoded client private key. Conflicts with `key`. Overrides TEMPORAL_TLS_KEY",
+				Optional:            true,
+			},
+			"ca": schema.StringAttribute{
+				MarkdownDescription: "PEM-encoded CA bundle used to verify the server. Conflicts with `ca_file`. Defaults to the system roots",
+				Optional:            true,
+			},
+			"ca_file": schema.StringAttribute{
+				MarkdownDescription: "Path to a PEM-encoded CA bundle used to verify the server. Conflicts with `ca`. Overrides TEMPORAL_TLS_CA",
+				Optional:            true,
+			},
+			"server_name": schema.StringAttribute{
+				MarkdownDescription: "Overrides the server name used to verify the server certificate. Overrides TEMPORAL_TLS_SERVER_NAME",
+				Optional:            true,
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				MarkdownDescription: "Skips verification of the server certificate. For development only",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+// buildTLSConfig creates a tls.Config from the `tls` block.
+// Returns nil if the block is absent.
+func buildTLSConfig(model *TemporalProviderTLSModel) (*tls.Config, error) {
+	if model == nil {
+		return nil, nil
+	}
+
+	certPEM, err := inlineOrFile("cert", model.Cert, model.CertFile)
+	if err != nil {
+		return nil, err
+	}
+	keyPEM, err := inlineOrFile("key", model.Key, model.KeyFile)
+	if err != nil {
+		return nil, err
+	}
+	caPEM, err := inlineOrFile("ca", model.CA, model.CAFile)
+	if err != nil {
+		return nil, err
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		ServerName:         model.ServerName.ValueString(),
+		InsecureSkipVerify: model.InsecureSkipVerify.ValueBool(),
+	}
+
+	if len(certPEM) != 0 || len(keyPEM) != 0 {
+		if len(certPEM) == 0 || len(keyPEM) == 0 {
+			return nil, fmt.Errorf("both a client certificate and key must be set for mutual TLS")
+		}
+		cert, err := tls.X509KeyPair(certPEM, keyPEM)
+		if err != nil {
+			return nil, fmt.Errorf("unable to load client certificate and key: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	if len(caPEM) != 0 {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caPEM) {
+			return nil, fmt.Errorf("unable to parse any certificates from the CA bundle")
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	return tlsConfig, nil
+}
+
+// bearerTLSConfig returns tlsConfig, or a default TLS configuration if it is nil and the
+// connection carries bearer credentials, like an API key or OAuth2 tokens, which must never be sent in the clear.
+func bearerTLSConfig(tlsConfig *tls.Config, hasBearerCredentials bool) *tls.Config {
+	if tlsConfig == nil && hasBearerCredentials {
+		return &tls.Config{MinVersion: tls.VersionTLS12}
+	}
+	return tlsConfig
+}
+
+// inlineOrFile returns the inline value if set, otherwise the contents of the file.
+// It is an error to set both.
+func inlineOrFile(name string, inline types.String, file types.String) ([]byte, error) {
+	inlineValue, fileValue := inline.ValueString(), file.ValueString()
+	if inlineValue != "" && fileValue != "" {
+		return nil, fmt.Errorf("only one of '%s' and '%s_file' may be set", name, name)
+	}
+	if inlineValue != "" {
+		return []byte(inlineValue), nil
+	}
+	if fileValue == "" {
+		return nil, nil
+	}
+	data, err := os.ReadFile(fileValue)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read '%s_file': %w", name, err)
+	}
+	return data, nil
+}
@@ -0,0 +1,110 @@
+package provider
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// testSelfSignedPEM returns a PEM-encoded self-signed certificate and its key.
+func testSelfSignedPEM(t *testing.T) (string, string) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "temporal-test"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+	return string(certPEM), string(keyPEM)
+}
+
+func TestBuildTLSConfig(t *testing.T) {
+	certPEM, keyPEM := testSelfSignedPEM(t)
+
+	dir := t.TempDir()
+	certFile := filepath.Join(dir, "client.pem")
+	if err := os.WriteFile(certFile, []byte(certPEM), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tlsConfig, err := buildTLSConfig(nil)
+	if err != nil || tlsConfig != nil {
+		t.Fatalf("expected nil config for absent block, got %v, %v", tlsConfig, err)
+	}
+
+	tlsConfig, err = buildTLSConfig(&TemporalProviderTLSModel{
+		CertFile:   types.StringValue(certFile),
+		Key:        types.StringValue(keyPEM),
+		CA:         types.StringValue(certPEM),
+		ServerName: types.StringValue("temporal.example.com"),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(tlsConfig.Certificates) != 1 {
+		t.Errorf("expected 1 client certificate, got %d", len(tlsConfig.Certificates))
+	}
+	if tlsConfig.RootCAs == nil {
+		t.Error("expected RootCAs to be set")
+	}
+	if tlsConfig.ServerName != "temporal.example.com" {
+		t.Errorf("unexpected ServerName %q", tlsConfig.ServerName)
+	}
+	if tlsConfig.InsecureSkipVerify {
+		t.Error("expected InsecureSkipVerify to be false")
+	}
+
+	if _, err := buildTLSConfig(&TemporalProviderTLSModel{Cert: types.StringValue(certPEM)}); err == nil {
+		t.Error("expected error for certificate without key")
+	}
+	if _, err := buildTLSConfig(&TemporalProviderTLSModel{
+		Cert:     types.StringValue(certPEM),
+		CertFile: types.StringValue(certFile),
+		Key:      types.StringValue(keyPEM),
+	}); err == nil {
+		t.Error("expected error for both 'cert' and 'cert_file'")
+	}
+	if _, err := buildTLSConfig(&TemporalProviderTLSModel{CA: types.StringValue("not a pem")}); err == nil {
+		t.Error("expected error for invalid CA bundle")
+	}
+}
+
+func TestBearerTLSConfig(t *testing.T) {
+	if tlsConfig := bearerTLSConfig(nil, false); tlsConfig != nil {
+		t.Errorf("expected plaintext without bearer credentials, got %v", tlsConfig)
+	}
+	if tlsConfig := bearerTLSConfig(nil, true); tlsConfig == nil || tlsConfig.MinVersion != tls.VersionTLS12 {
+		t.Errorf("expected TLS for bearer credentials, got %v", tlsConfig)
+	}
+	configured := &tls.Config{ServerName: "temporal.example.com"}
+	if tlsConfig := bearerTLSConfig(configured, true); tlsConfig != configured {
+		t.Errorf("expected the `tls` block's config to be kept, got %v", tlsConfig)
+	}
+}
@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/api/workflowservice/v1"
+
+	temporalClient "go.temporal.io/sdk/client"
+)
+
+// validateTemporalConnection checks that the Temporal Server is healthy and that
+// the namespace exists, reporting a single diagnostic on `hostport` or `namespace`.
+func validateTemporalConnection(ctx context.Context, tclient temporalClient.Client, hostPort string, namespace string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if _, err := tclient.CheckHealth(ctx, &temporalClient.CheckHealthRequest{}); err != nil {
+		diags.AddAttributeError(
+			path.Root("hostport"),
+			"Unable to connect to Temporal Server",
+			fmt.Sprintf("Health check of Temporal Server '%s' failed: %s", hostPort, err),
+		)
+		return diags
+	}
+
+	_, err := tclient.WorkflowService().DescribeNamespace(ctx, &workflowservice.DescribeNamespaceRequest{
+		Namespace: namespace,
+	})
+	if err != nil {
+		var namespaceNotFound *serviceerror.NamespaceNotFound
+		var notFound *serviceerror.NotFound
+		if errors.As(err, &namespaceNotFound) || errors.As(err, &notFound) {
+			diags.AddAttributeError(
+				path.Root("namespace"),
+				"Temporal namespace not found",
+				fmt.Sprintf("Namespace '%s' does not exist on Temporal Server '%s'", namespace, hostPort),
+			)
+		} else {
+			diags.AddAttributeError(
+				path.Root("namespace"),
+				"Unable to describe Temporal namespace",
+				fmt.Sprintf("DescribeNamespace of '%s' on Temporal Server '%s' failed: %s", namespace, hostPort, err),
+			)
+		}
+	}
+	return diags
+}
@@ -0,0 +1,89 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/api/workflowservice/v1"
+	"google.golang.org/grpc"
+
+	temporalClient "go.temporal.io/sdk/client"
+)
+
+// testValidateClient stubs the Temporal Client calls made by validateTemporalConnection.
+type testValidateClient struct {
+	temporalClient.Client
+	healthErr       error
+	workflowService *testWorkflowService
+}
+
+func (c *testValidateClient) CheckHealth(ctx context.Context, req *temporalClient.CheckHealthRequest) (*temporalClient.CheckHealthResponse, error) {
+	return &temporalClient.CheckHealthResponse{}, c.healthErr
+}
+
+func (c *testValidateClient) WorkflowService() workflowservice.WorkflowServiceClient {
+	return c.workflowService
+}
+
+type testWorkflowService struct {
+	workflowservice.WorkflowServiceClient
+	namespaceErr error
+}
+
+func (s *testWorkflowService) DescribeNamespace(ctx context.Context, req *workflowservice.DescribeNamespaceRequest, opts ...grpc.CallOption) (*workflowservice.DescribeNamespaceResponse, error) {
+	return &workflowservice.DescribeNamespaceResponse{}, s.namespaceErr
+}
+
+func TestValidateTemporalConnection(t *testing.T) {
+	testCases := map[string]struct {
+		healthErr    error
+		namespaceErr error
+		expectError  bool
+		expectedPath path.Path
+	}{
+		"valid": {},
+		"unhealthy": {
+			healthErr:    errors.New("connection refused"),
+			expectError:  true,
+			expectedPath: path.Root("hostport"),
+		},
+		"namespace not found": {
+			namespaceErr: serviceerror.NewNamespaceNotFound("typo"),
+			expectError:  true,
+			expectedPath: path.Root("namespace"),
+		},
+		"namespace error": {
+			namespaceErr: serviceerror.NewPermissionDenied("denied", ""),
+			expectError:  true,
+			expectedPath: path.Root("namespace"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			tclient := &testValidateClient{
+				healthErr:       testCase.healthErr,
+				workflowService: &testWorkflowService{namespaceErr: testCase.namespaceErr},
+			}
+			diags := validateTemporalConnection(context.Background(), tclient, "localhost:7233", "typo")
+			if !testCase.expectError {
+				if diags.HasError() {
+					t.Fatalf("unexpected diagnostics: %v", diags)
+				}
+				return
+			}
+			if diags.ErrorsCount() != 1 {
+				t.Fatalf("expected exactly one error, got: %v", diags)
+			}
+			withPath, ok := diags.Errors()[0].(diag.DiagnosticWithPath)
+			if !ok || !withPath.Path().Equal(testCase.expectedPath) {
+				t.Errorf("expected error on %s, got: %v", testCase.expectedPath, diags)
+			}
+		})
+	}
+}
@@ -4,12 +4,14 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -21,6 +23,8 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &ScheduleResource{}
 var _ resource.ResourceWithImportState = &ScheduleResource{}
+var _ resource.ResourceWithModifyPlan = &ScheduleResource{}
+var _ resource.ResourceWithValidateConfig = &ScheduleResource{}
 
 func NewScheduleResource() resource.Resource {
 	return &ScheduleResource{}
@@ -28,13 +32,32 @@ func NewScheduleResource() resource.Resource {
 
 // ScheduleResource defines the resource implementation.
 type ScheduleResource struct {
-	tclient temporalClient.Client
+	provider *TemporalProviderData
 }
 
 // ScheduleResourceModel describes the resource data model.
 type ScheduleResourceModel struct {
 	ScheduleId types.String `tfsdk:"id"`
+	Namespace  types.String `tfsdk:"namespace"`
 	DescJson   types.String `tfsdk:"desc"`
+	GrpcMeta   types.Map    `tfsdk:"grpc_meta"`
+
+	Memo                types.Map `tfsdk:"memo"`
+	MemoAll             types.Map `tfsdk:"memo_all"`
+	SearchAttributes    types.Map `tfsdk:"search_attributes"`
+	SearchAttributesAll types.Map `tfsdk:"search_attributes_all"`
+
+	WorkflowArgs []ScheduleWorkflowArgModel `tfsdk:"workflow_args"`
+
+	Intervals []ScheduleIntervalModel `tfsdk:"interval"`
+	Calendars []ScheduleCalendarModel `tfsdk:"calendar"`
+	Skip      []ScheduleCalendarModel `tfsdk:"skip"`
+
+	CronExpressions []types.String `tfsdk:"cron_expressions"`
+	StartAt         types.String   `tfsdk:"start_at"`
+	EndAt           types.String   `tfsdk:"end_at"`
+	Jitter          types.String   `tfsdk:"jitter"`
+	TimeZoneName    types.String   `tfsdk:"time_zone_name"`
 }
 
 func (r *ScheduleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -47,19 +70,113 @@ func (r *ScheduleResource) Schema(ctx context.Context, req resource.SchemaReques
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Required:            true,
-				MarkdownDescription: "Schedule ID",
+				MarkdownDescription: "Schedule ID. Changing it replaces the Schedule",
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
 				},
 			},
 			"desc": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: "Schedule description in JSON",
+			},
+			"namespace": schema.StringAttribute{
+				MarkdownDescription: "Temporal namespace of the Schedule. Defaults to the provider's namespace",
+				Optional:            true,
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"grpc_meta": schema.MapAttribute{
+				MarkdownDescription: "gRPC metadata headers sent on requests for this Schedule. Overrides the provider's `grpc_meta`",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"memo": schema.MapAttribute{
+				MarkdownDescription: "Memo of the Schedule, merged over the provider's `default_memo`. The memo of an existing Schedule cannot be updated, so changing the merged memo, including the provider's `default_memo`, replaces the Schedule",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"memo_all": schema.MapAttribute{
+				MarkdownDescription: "Memo of the Schedule as read from the server, including the provider's `default_memo`",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"search_attributes": schema.MapAttribute{
+				MarkdownDescription: "Search attributes of the Schedule, merged over the provider's `default_search_attributes`. The search attributes must be registered on the namespace. " +
+					"The search attributes of an existing Schedule cannot be updated, so changing the merged search attributes, including the provider's `default_search_attributes`, replaces the Schedule",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"search_attributes_all": schema.MapAttribute{
+				MarkdownDescription: "Search attributes of the Schedule as read from the server, including the provider's `default_search_attributes`",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"workflow_args": schema.ListNestedAttribute{
+				MarkdownDescription: "Arguments passed to the Schedule's workflow, each encoded with its own `encoding`, or else the provider's `data_converter`. Kept as written in state",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"value": schema.StringAttribute{
+							MarkdownDescription: "Value of the argument. With a JSON `encoding`, it is JSON text sent as is, such as `jsonencode({ id = 1 })`; " +
+								"with `binary/plain`, its bytes are sent; with `binary/null`, it must be unset",
+							Optional: true,
+						},
+						"encoding": schema.StringAttribute{
+							MarkdownDescription: "Payload encoding of the argument, overriding the provider's `data_converter`: `json/plain`, `json/protobuf`, `binary/null` or `binary/plain`",
+							Optional:            true,
+							Validators: []validator.String{
+								stringOneOf(dataConverterEncodings()...),
+							},
+						},
+					},
+				},
+			},
+			"cron_expressions": schema.ListAttribute{
+				MarkdownDescription: "Cron expressions matching times, such as `0 12 * * MON-FRI`, `CRON_TZ=Europe/Paris 0 9 * * *` or `@daily`. " +
+					"The server describes them as calendars and intervals, which are not shown in the `calendar` and `interval` blocks",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"start_at": schema.StringAttribute{
+				MarkdownDescription: "Time before which no times are matched, in RFC3339 format such as `2024-01-01T00:00:00Z`",
+				Optional:            true,
+				Validators: []validator.String{
+					rfc3339Validator{},
+				},
+			},
+			"end_at": schema.StringAttribute{
+				MarkdownDescription: "Time after which no times are matched, in RFC3339 format such as `2024-12-31T23:59:59Z`. Must not be before `start_at`",
+				Optional:            true,
+				Validators: []validator.String{
+					rfc3339Validator{},
+				},
+			},
+			"jitter": schema.StringAttribute{
+				MarkdownDescription: "Maximum random delay added to each matched time, as a duration such as `30s`, to spread the start of many Schedules. Defaults to `0s`",
+				Optional:            true,
+				Validators: []validator.String{
+					durationValidator{},
+				},
+			},
+			"time_zone_name": schema.StringAttribute{
+				MarkdownDescription: "IANA time zone name, such as `Europe/Paris`, in which calendars and cron expressions are interpreted, following daylight saving time. Defaults to UTC",
+				Optional:            true,
+				Validators: []validator.String{
+					timeZoneValidator{},
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"interval": scheduleIntervalBlock(),
+			"calendar": scheduleCalendarBlock("Matches times whose fields all match one of the field's ranges. " +
+				"For example, `hour = [{ start = 9, end = 17 }]` and `day_of_week = [{ start = 1, end = 5 }]` matches each hour of business days from 9:00 to 17:00"),
+			"skip": scheduleCalendarBlock("Excludes the times matched by the calendar, with the same fields as `calendar`. " +
+				"As `second`, `minute` and `hour` default to 0, skipping whole days requires their full ranges. For example, " +
+				"`month = [{ start = 12 }]`, `day_of_month = [{ start = 25 }]`, `hour = [{ start = 0, end = 23 }]`, " +
+				"`minute = [{ start = 0, end = 59 }]` and `second = [{ start = 0, end = 59 }]` skips Christmas Day"),
+		},
 	}
 }
 
@@ -69,18 +186,73 @@ func (r *ScheduleResource) Configure(ctx context.Context, req resource.Configure
 		return
 	}
 
-	tclient, ok := req.ProviderData.(temporalClient.Client)
+	providerData, ok := req.ProviderData.(*TemporalProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected temporalClient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *TemporalProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
-	r.tclient = tclient
+	r.provider = providerData
+}
+
+func (r *ScheduleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var startAt, endAt types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("start_at"), &startAt)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("end_at"), &endAt)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	checkScheduleTimeBounds(startAt, endAt, &resp.Diagnostics)
+}
+
+func (r *ScheduleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to merge when destroying, or before the provider is configured
+	if req.Plan.Raw.IsNull() || r.provider == nil {
+		r.provider.checkReadOnlyPlan(req, resp, "temporal_schedule")
+		return
+	}
+	defer r.provider.checkReadOnlyPlan(req, resp, "temporal_schedule")
+
+	// Only the merged attributes are read, as the rest of the plan may still be unknown
+	var memo, searchAttributes types.Map
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("memo"), &memo)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("search_attributes"), &searchAttributes)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	memoAll := mergeScheduleDefaults(r.provider.defaultMemo, memo)
+	searchAttributesAll := mergeScheduleDefaults(r.provider.defaultSearchAttributes, searchAttributes)
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("memo_all"), memoAll)...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("search_attributes_all"), searchAttributesAll)...)
+
+	// The memo and search attributes of an existing Schedule cannot be updated, so it is replaced
+	if !req.State.Raw.IsNull() {
+		var stateMemoAll, stateSearchAttributesAll types.Map
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("memo_all"), &stateMemoAll)...)
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("search_attributes_all"), &stateSearchAttributesAll)...)
+		if !memoAll.Equal(stateMemoAll) {
+			resp.RequiresReplace = append(resp.RequiresReplace, path.Root("memo_all"))
+		}
+		if !searchAttributesAll.Equal(stateSearchAttributesAll) {
+			resp.RequiresReplace = append(resp.RequiresReplace, path.Root("search_attributes_all"))
+		}
+	}
+
+	// Only creating or updating the Schedule needs a server supporting Schedules,
+	// and a read-only provider refuses both without asking the server
+	if !r.provider.readOnly && (req.State.Raw.IsNull() || !resp.Plan.Raw.Equal(req.State.Raw)) {
+		resp.Diagnostics.Append(r.provider.checkSchedulesSupported(ctx)...)
+	}
 }
 
 func (r *ScheduleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.provider.checkReadOnly("create", "temporal_schedule", &resp.Diagnostics) {
+		return
+	}
+
 	// Read Terraform plan data into the model
 	var data *ScheduleResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -88,21 +260,48 @@ func (r *ScheduleResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
-	scheduleHandle, err := r.tclient.ScheduleClient().Create(ctx, temporalClient.ScheduleOptions{
-		ID: data.ScheduleId.ValueString(),
-		// TODO: we must express all this in Terraform!
-		// Spec: &temporalClient.ScheduleSpec{
-		// 	Intervals: []temporalClient.ScheduleIntervalSpec{
-		// 		{Every: 10},
-		// 	},
-		// },
+	ctx, diags := contextWithGrpcMeta(ctx, data.GrpcMeta)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tclient, err := r.provider.clients.get(data.Namespace.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("namespace"), "Temporal Error", err.Error())
+		return
+	}
+
+	var memo, searchAttributes map[string]string
+	resp.Diagnostics.Append(data.MemoAll.ElementsAs(ctx, &memo, false)...)
+	resp.Diagnostics.Append(data.SearchAttributesAll.ElementsAs(ctx, &searchAttributes, false)...)
+	spec := buildScheduleSpec(data, &resp.Diagnostics)
+	args := buildScheduleWorkflowArgs(data.WorkflowArgs, r.provider.defaultEncoding, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	scheduleOptions := temporalClient.ScheduleOptions{
+		ID:   data.ScheduleId.ValueString(),
+		Spec: *spec,
+		// TODO: we must express the action in Terraform!
 		Action: &temporalClient.ScheduleWorkflowAction{
-			Workflow: "foo",
-			//Args:      {"foo"},
+			Workflow:  "foo",
+			Args:      args,
 			ID:        "some-id-workflow",
 			TaskQueue: "queue",
 		},
-		Overlap: temporalEnums.SCHEDULE_OVERLAP_POLICY_SKIP,
+		Overlap:          temporalEnums.SCHEDULE_OVERLAP_POLICY_SKIP,
+		Memo:             stringMapToInterfaces(memo),
+		SearchAttributes: stringMapToInterfaces(searchAttributes),
+	}
+
+	// Creating is not idempotent, so it is never retried
+	var scheduleHandle temporalClient.ScheduleHandle
+	err = r.provider.retry.call(ctx, "CreateSchedule", false, func(ctx context.Context) error {
+		var err error
+		scheduleHandle, err = tclient.ScheduleClient().Create(ctx, scheduleOptions)
+		return err
 	})
 	if err != nil {
 		resp.Diagnostics.AddError("Temporal Error", fmt.Sprintf("Create: Unable to create Schedule: %s", err))
@@ -111,7 +310,7 @@ func (r *ScheduleResource) Create(ctx context.Context, req resource.CreateReques
 
 	data.ScheduleId = types.StringValue(scheduleHandle.GetID())
 
-	desc, err := scheduleHandle.Describe(ctx)
+	desc, err := r.provider.describeSchedule(ctx, tclient, scheduleHandle.GetID())
 	if err != nil {
 		resp.Diagnostics.AddError("Temporal Error", fmt.Sprintf("Create: Unable to describe Schedule after create: %s", err))
 		return
@@ -136,8 +335,26 @@ func (r *ScheduleResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
+	ctx, diags := contextWithGrpcMeta(ctx, data.GrpcMeta)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tclient, err := r.provider.clients.get(data.Namespace.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("namespace"), "Temporal Error", err.Error())
+		return
+	}
+
 	// Fetch the Schedule's description from the Server
-	desc, err := r.tclient.ScheduleClient().GetHandle(ctx, data.ScheduleId.ValueString()).Describe(ctx)
+	desc, err := r.provider.describeSchedule(ctx, tclient, data.ScheduleId.ValueString())
+	if isNotFoundError(err) {
+		// The Schedule was deleted outside of Terraform, so it is planned to be created again
+		tflog.Warn(ctx, fmt.Sprintf("Schedule %s not found, removing it from state", data.ScheduleId.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("Temporal Error", fmt.Sprintf("Read: Unable to describe Schedule %s : %s", data.ScheduleId.ValueString(), err))
 		return
@@ -148,6 +365,9 @@ func (r *ScheduleResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 	data.DescJson = basetypes.NewStringValue(string(jsonBytes))
+	readScheduleSpec(data, desc.Schedule.Spec)
+	data.MemoAll = readSchedulePayloads(desc.ScheduleDescription.Memo.GetFields(), r.provider.dataConverter)
+	data.SearchAttributesAll = readSchedulePayloads(desc.ScheduleDescription.SearchAttributes.GetIndexedFields(), r.provider.dataConverter)
 	tflog.Trace(ctx, fmt.Sprintf("Read ScheduledWorkflow resource %s", data.ScheduleId.ValueString()))
 
 	// Save updated data into Terraform state
@@ -155,6 +375,10 @@ func (r *ScheduleResource) Read(ctx context.Context, req resource.ReadRequest, r
 }
 
 func (r *ScheduleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.provider.checkReadOnly("update", "temporal_schedule", &resp.Diagnostics) {
+		return
+	}
+
 	// Read Terraform plan data into the model
 	var data *ScheduleResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -162,11 +386,65 @@ func (r *ScheduleResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
+	ctx, diags := contextWithGrpcMeta(ctx, data.GrpcMeta)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tclient, err := r.provider.clients.get(data.Namespace.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("namespace"), "Temporal Error", err.Error())
+		return
+	}
+
+	spec := buildScheduleSpec(data, &resp.Diagnostics)
+	args := buildScheduleWorkflowArgs(data.WorkflowArgs, r.provider.defaultEncoding, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Replacing the spec and arguments is idempotent, so it is retried
+	scheduleID := data.ScheduleId.ValueString()
+	err = r.provider.retry.call(ctx, "UpdateSchedule", true, func(ctx context.Context) error {
+		return tclient.ScheduleClient().GetHandle(ctx, scheduleID).Update(ctx, temporalClient.ScheduleUpdateOptions{
+			DoUpdate: func(input temporalClient.ScheduleUpdateInput) (*temporalClient.ScheduleUpdate, error) {
+				schedule := input.Description.Schedule
+				schedule.Spec = spec
+				if action, ok := schedule.Action.(*temporalClient.ScheduleWorkflowAction); ok {
+					action.Args = args
+				}
+				return &temporalClient.ScheduleUpdate{Schedule: &schedule}, nil
+			},
+		})
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Temporal Error", fmt.Sprintf("Update: Unable to update Schedule %s : %s", scheduleID, err))
+		return
+	}
+
+	desc, err := r.provider.describeSchedule(ctx, tclient, scheduleID)
+	if err != nil {
+		resp.Diagnostics.AddError("Temporal Error", fmt.Sprintf("Update: Unable to describe Schedule after update: %s", err))
+		return
+	}
+	jsonBytes, err := json.Marshal(desc)
+	if err != nil {
+		resp.Diagnostics.AddError("Temporal Error", fmt.Sprintf("Update: Unable to marshal Schedule description after update: %s", err))
+		return
+	}
+	data.DescJson = basetypes.NewStringValue(string(jsonBytes))
+	tflog.Trace(ctx, fmt.Sprintf("Updated Schedule resource %s", scheduleID))
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *ScheduleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.provider.checkReadOnly("delete", "temporal_schedule", &resp.Diagnostics) {
+		return
+	}
+
 	// Read Terraform prior state data into the model
 	var data *ScheduleResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -174,14 +452,78 @@ func (r *ScheduleResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
-	// Fetch the Schedule from the Server
-	err := r.tclient.ScheduleClient().GetHandle(ctx, data.ScheduleId.ValueString()).Delete(ctx)
+	ctx, diags := contextWithGrpcMeta(ctx, data.GrpcMeta)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tclient, err := r.provider.clients.get(data.Namespace.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("namespace"), "Temporal Error", err.Error())
+		return
+	}
+
+	// A Schedule that is not found was already deleted, possibly by an earlier
+	// attempt whose response was lost, so deleting is idempotent and retried
+	err = r.provider.retry.call(ctx, "DeleteSchedule", true, func(ctx context.Context) error {
+		err := tclient.ScheduleClient().GetHandle(ctx, data.ScheduleId.ValueString()).Delete(ctx)
+		if isNotFoundError(err) {
+			return nil
+		}
+		return err
+	})
 	if err != nil {
 		resp.Diagnostics.AddError("Temporal Error", fmt.Sprintf("Delete: Unable to delete Schedule : %s", err))
+		return
 	}
 	tflog.Trace(ctx, fmt.Sprintf("Deleted ScheduledWorkflow resource %s", data.ScheduleId.ValueString()))
 }
 
 func (r *ScheduleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	namespace, scheduleID, err := resolveScheduleImportID(req.ID, func(scheduleID string) error {
+		tclient, err := r.provider.clients.get("")
+		if err != nil {
+			return err
+		}
+		_, err = r.provider.describeSchedule(ctx, tclient, scheduleID)
+		return err
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Schedule import ID", err.Error())
+		return
+	}
+	if namespace != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("namespace"), namespace)...)
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), scheduleID)...)
+}
+
+// resolveScheduleImportID returns the namespace and ID of the Schedule imported as importID.
+// Schedule IDs may contain `/`, so an importID containing one is first described as a whole in the provider's namespace,
+// and only parsed as `<namespace>/<id>` if that Schedule is not found.
+func resolveScheduleImportID(importID string, describe func(scheduleID string) error) (namespace string, scheduleID string, err error) {
+	if strings.Contains(importID, "/") {
+		err := describe(importID)
+		if err == nil {
+			return "", importID, nil
+		}
+		if !isNotFoundError(err) {
+			return "", "", fmt.Errorf("unable to describe Schedule '%s': %w", importID, err)
+		}
+	}
+	return parseScheduleImportID(importID)
+}
+
+// parseScheduleImportID splits an import ID of the form `<id>`, or `<namespace>/<id>` for a Schedule
+// outside the provider's namespace.  Namespace names cannot contain `/`, so the ID is everything after the first one.
+func parseScheduleImportID(importID string) (namespace string, scheduleID string, err error) {
+	namespace, scheduleID, ok := strings.Cut(importID, "/")
+	if !ok {
+		namespace, scheduleID = "", importID
+	}
+	if (ok && namespace == "") || scheduleID == "" {
+		return "", "", fmt.Errorf("expected an import ID of the form '<id>' or '<namespace>/<id>', got '%s'", importID)
+	}
+	return namespace, scheduleID, nil
 }
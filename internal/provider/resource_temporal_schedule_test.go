@@ -46,5 +46,9 @@ func testAccScheduleResourceConfig(configurableAttribute string) string {
 	return `
 resource "temporal_schedule" "test" {
   id = "example-id"
+
+  interval {
+    every = "1h"
+  }
 }`
 }
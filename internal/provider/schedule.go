@@ -0,0 +1,181 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/sdk/converter"
+
+	temporalClient "go.temporal.io/sdk/client"
+)
+
+// scheduleDescription is the description of a Schedule, with its memo and search attributes
+// decoded.  They shadow the encoded payloads of the embedded description when marshaled to JSON.
+type scheduleDescription struct {
+	*temporalClient.ScheduleDescription
+	Memo             map[string]interface{} `json:",omitempty"`
+	SearchAttributes map[string]interface{} `json:",omitempty"`
+}
+
+// describeSchedule fetches the description of the Schedule with the given ID.
+func (p *TemporalProviderData) describeSchedule(ctx context.Context, tclient temporalClient.Client, scheduleID string) (*scheduleDescription, error) {
+	var desc *temporalClient.ScheduleDescription
+	err := p.retry.call(ctx, "DescribeSchedule", true, func(ctx context.Context) error {
+		var err error
+		desc, err = tclient.ScheduleClient().GetHandle(ctx, scheduleID).Describe(ctx)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return decodeScheduleDescription(desc, p.dataConverter), nil
+}
+
+// checkSchedulesSupported fails if the connected Temporal Server does not support Schedules,
+// so that plans fail early instead of applies failing with an opaque gRPC error.
+// If the capabilities cannot be detected, it warns once per provider and leaves the error to the request itself.
+func (p *TemporalProviderData) checkSchedulesSupported(ctx context.Context) diag.Diagnostics {
+	var diags diag.Diagnostics
+	capabilities, err := p.serverCapabilities(ctx)
+	if err != nil {
+		if p.capabilities.warnDetectionFailure() {
+			diags.AddWarning("Unable to detect Temporal Server capabilities", err.Error())
+		}
+		return diags
+	}
+	if err := capabilities.requireSchedules(); err != nil {
+		diags.AddError("Temporal Server does not support Schedules", err.Error())
+	}
+	return diags
+}
+
+// decodeScheduleDescription replaces the encoded payloads of the Schedule's workflow
+// arguments and memo with their decoded, human-readable form, and decodes the
+// Schedule's own memo and search attributes.
+func decodeScheduleDescription(desc *temporalClient.ScheduleDescription, dataConverter converter.DataConverter) *scheduleDescription {
+	decoded := &scheduleDescription{
+		ScheduleDescription: desc,
+		Memo:                decodePayloads(desc.Memo.GetFields(), dataConverter),
+		SearchAttributes:    decodePayloads(desc.SearchAttributes.GetIndexedFields(), dataConverter),
+	}
+	action, ok := desc.Schedule.Action.(*temporalClient.ScheduleWorkflowAction)
+	if !ok {
+		return decoded
+	}
+	for i, arg := range action.Args {
+		if payload, ok := arg.(*commonpb.Payload); ok {
+			action.Args[i] = dataConverter.ToString(payload)
+		}
+	}
+	for key, value := range action.Memo {
+		if payload, ok := value.(*commonpb.Payload); ok {
+			action.Memo[key] = dataConverter.ToString(payload)
+		}
+	}
+	return decoded
+}
+
+// decodePayloads returns the human-readable form of each payload.  Nil if there are none.
+func decodePayloads(payloads map[string]*commonpb.Payload, dataConverter converter.DataConverter) map[string]interface{} {
+	if len(payloads) == 0 {
+		return nil
+	}
+	decoded := make(map[string]interface{}, len(payloads))
+	for key, payload := range payloads {
+		decoded[key] = dataConverter.ToString(payload)
+	}
+	return decoded
+}
+
+// ScheduleWorkflowArgModel describes an argument of a Schedule's workflow.
+type ScheduleWorkflowArgModel struct {
+	Value    types.String `tfsdk:"value"`
+	Encoding types.String `tfsdk:"encoding"`
+}
+
+// buildScheduleWorkflowArgs converts the workflow arguments of a Schedule's model into the arguments
+// of its action.  Arguments without their own encoding use defaultEncoding, the provider's `data_converter`.
+func buildScheduleWorkflowArgs(workflowArgs []ScheduleWorkflowArgModel, defaultEncoding string, diags *diag.Diagnostics) []interface{} {
+	var args []interface{}
+	for i, workflowArg := range workflowArgs {
+		encoding := defaultEncoding
+		if !isStringUnset(workflowArg.Encoding) {
+			encoding = workflowArg.Encoding.ValueString()
+		}
+		arg, err := encodeArgument(workflowArg.Value.ValueString(), encoding)
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("workflow_args").AtListIndex(i),
+				"Invalid Schedule workflow argument",
+				err.Error(),
+			)
+			continue
+		}
+		args = append(args, arg)
+	}
+	return args
+}
+
+// mergeScheduleDefaults returns the provider's defaults overlaid with the Schedule's own values,
+// which win on conflict.  The result is unknown if values is unknown, and null if it is empty.
+func mergeScheduleDefaults(defaults map[string]string, values types.Map) types.Map {
+	if values.IsUnknown() {
+		return types.MapUnknown(types.StringType)
+	}
+	elements := map[string]attr.Value{}
+	for k, v := range defaults {
+		elements[k] = types.StringValue(v)
+	}
+	if !values.IsNull() {
+		for k, v := range values.Elements() {
+			elements[k] = v
+		}
+	}
+	if len(elements) == 0 {
+		return types.MapNull(types.StringType)
+	}
+	return types.MapValueMust(types.StringType, elements)
+}
+
+// scheduleInternalSearchAttributes are set by the Temporal Server on every Schedule.
+var scheduleInternalSearchAttributes = map[string]bool{
+	"TemporalNamespaceDivision": true,
+	"TemporalSchedulePaused":    true,
+}
+
+// readSchedulePayloads converts the memo or search attributes described by the server to a map of
+// strings, leaving out the server's internal search attributes.  Null if there are none.
+func readSchedulePayloads(payloads map[string]*commonpb.Payload, dataConverter converter.DataConverter) types.Map {
+	elements := map[string]attr.Value{}
+	for key, payload := range payloads {
+		if scheduleInternalSearchAttributes[key] {
+			continue
+		}
+		var value string
+		if err := dataConverter.FromPayload(payload, &value); err != nil {
+			value = dataConverter.ToString(payload)
+		}
+		elements[key] = types.StringValue(value)
+	}
+	if len(elements) == 0 {
+		return types.MapNull(types.StringType)
+	}
+	return types.MapValueMust(types.StringType, elements)
+}
+
+// stringMapToInterfaces converts a map of strings, like a Schedule's merged memo,
+// to the map of arbitrary values expected by the Temporal SDK.  Nil if the map is empty.
+func stringMapToInterfaces(values map[string]string) map[string]interface{} {
+	if len(values) == 0 {
+		return nil
+	}
+	result := make(map[string]interface{}, len(values))
+	for k, v := range values {
+		result[k] = v
+	}
+	return result
+}
@@ -0,0 +1,453 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	temporalClient "go.temporal.io/sdk/client"
+)
+
+// ScheduleIntervalModel describes an `interval` block of a Schedule's spec.
+type ScheduleIntervalModel struct {
+	Every  types.String `tfsdk:"every"`
+	Offset types.String `tfsdk:"offset"`
+}
+
+func scheduleIntervalBlock() schema.Block {
+	return schema.ListNestedBlock{
+		MarkdownDescription: "Matches times that are a multiple of `every` since the epoch, shifted by `offset`. " +
+			"For example, `every = \"1h\"` and `offset = \"15m\"` matches a quarter past each hour",
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"every": schema.StringAttribute{
+					MarkdownDescription: "Period of the interval, as a duration greater than zero such as `1h`",
+					Required:            true,
+					Validators: []validator.String{
+						durationValidator{positive: true},
+					},
+				},
+				"offset": schema.StringAttribute{
+					MarkdownDescription: "Offset of the interval, as a duration such as `15m`. Defaults to `0s`",
+					Optional:            true,
+					Validators: []validator.String{
+						durationValidator{},
+					},
+				},
+			},
+		},
+	}
+}
+
+// ScheduleCalendarModel describes a `calendar` block of a Schedule's spec.
+type ScheduleCalendarModel struct {
+	Second     []ScheduleRangeModel `tfsdk:"second"`
+	Minute     []ScheduleRangeModel `tfsdk:"minute"`
+	Hour       []ScheduleRangeModel `tfsdk:"hour"`
+	DayOfMonth []ScheduleRangeModel `tfsdk:"day_of_month"`
+	Month      []ScheduleRangeModel `tfsdk:"month"`
+	Year       []ScheduleRangeModel `tfsdk:"year"`
+	DayOfWeek  []ScheduleRangeModel `tfsdk:"day_of_week"`
+	Comment    types.String         `tfsdk:"comment"`
+}
+
+// ScheduleRangeModel describes a range of a calendar field, such as `{ start = 9, end = 17 }`.
+type ScheduleRangeModel struct {
+	Start types.Int64 `tfsdk:"start"`
+	End   types.Int64 `tfsdk:"end"`
+	Step  types.Int64 `tfsdk:"step"`
+}
+
+// scheduleRangeOrderValidator checks that the end of a calendar range is not before its start.
+type scheduleRangeOrderValidator struct{}
+
+func (v scheduleRangeOrderValidator) Description(ctx context.Context) string {
+	return "end must not be before start"
+}
+
+func (v scheduleRangeOrderValidator) MarkdownDescription(ctx context.Context) string {
+	return "`end` must not be before `start`"
+}
+
+func (v scheduleRangeOrderValidator) ValidateObject(ctx context.Context, req validator.ObjectRequest, resp *validator.ObjectResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	start, startOk := req.ConfigValue.Attributes()["start"].(types.Int64)
+	end, endOk := req.ConfigValue.Attributes()["end"].(types.Int64)
+	if !startOk || !endOk || start.IsNull() || start.IsUnknown() || end.IsNull() || end.IsUnknown() {
+		return
+	}
+	if end.ValueInt64() < start.ValueInt64() {
+		resp.Diagnostics.AddAttributeError(
+			req.Path.AtName("end"),
+			"Invalid Schedule calendar range",
+			fmt.Sprintf("Range end %d must not be before its start %d", end.ValueInt64(), start.ValueInt64()),
+		)
+	}
+}
+
+// scheduleCalendarDefaults are the ranges the Temporal SDK applies to unset calendar fields.
+var scheduleCalendarDefaults = struct {
+	second, minute, hour, dayOfMonth, month, year, dayOfWeek []temporalClient.ScheduleRange
+}{
+	second:     []temporalClient.ScheduleRange{{Start: 0}},
+	minute:     []temporalClient.ScheduleRange{{Start: 0}},
+	hour:       []temporalClient.ScheduleRange{{Start: 0}},
+	dayOfMonth: []temporalClient.ScheduleRange{{Start: 1, End: 31}},
+	month:      []temporalClient.ScheduleRange{{Start: 1, End: 12}},
+	year:       nil, // all years
+	dayOfWeek:  []temporalClient.ScheduleRange{{Start: 0, End: 6}},
+}
+
+func scheduleCalendarBlock(description string) schema.Block {
+	// bounds validate the start and end of the field's ranges
+	rangeAttribute := func(description string, bounds ...validator.Int64) schema.Attribute {
+		return schema.ListNestedAttribute{
+			MarkdownDescription: description,
+			Optional:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"start": schema.Int64Attribute{
+						MarkdownDescription: "Start of the range, inclusive",
+						Required:            true,
+						Validators:          bounds,
+					},
+					"end": schema.Int64Attribute{
+						MarkdownDescription: "End of the range, inclusive, not before `start`. Defaults to `start`",
+						Optional:            true,
+						Validators:          bounds,
+					},
+					"step": schema.Int64Attribute{
+						MarkdownDescription: "Step between matching values of the range, at least 1. Defaults to 1",
+						Optional:            true,
+						Validators: []validator.Int64{
+							int64AtLeast(1),
+						},
+					},
+				},
+				Validators: []validator.Object{
+					scheduleRangeOrderValidator{},
+				},
+			},
+		}
+	}
+
+	return schema.ListNestedBlock{
+		MarkdownDescription: description,
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"second":       rangeAttribute("Seconds to match, from 0 to 59. Defaults to 0", int64Between(0, 59)),
+				"minute":       rangeAttribute("Minutes to match, from 0 to 59. Defaults to 0", int64Between(0, 59)),
+				"hour":         rangeAttribute("Hours to match, from 0 to 23. Defaults to 0", int64Between(0, 23)),
+				"day_of_month": rangeAttribute("Days of the month to match, from 1 to 31. Defaults to all days", int64Between(1, 31)),
+				"month":        rangeAttribute("Months to match, from 1 to 12. Defaults to all months", int64Between(1, 12)),
+				"year":         rangeAttribute("Years to match. Defaults to all years"),
+				"day_of_week":  rangeAttribute("Days of the week to match, from 0 for Sunday to 6 for Saturday. Defaults to all days", int64Between(0, 6)),
+				"comment": schema.StringAttribute{
+					MarkdownDescription: "Description of the calendar",
+					Optional:            true,
+				},
+			},
+		},
+	}
+}
+
+// checkScheduleTimeBounds reports an error on end_at if it is before start_at.
+// Times that are unset, unknown or invalid are left to their attribute validators.
+func checkScheduleTimeBounds(startAtAttribute types.String, endAtAttribute types.String, diags *diag.Diagnostics) {
+	var startAt, endAt time.Time
+	var parseDiags diag.Diagnostics
+	parseTimeAttribute(&startAt, startAtAttribute, path.Root("start_at"), &parseDiags)
+	parseTimeAttribute(&endAt, endAtAttribute, path.Root("end_at"), &parseDiags)
+	if !startAt.IsZero() && !endAt.IsZero() && endAt.Before(startAt) {
+		diags.AddAttributeError(
+			path.Root("end_at"),
+			"Invalid Schedule time bounds",
+			"Schedule 'end_at' must not be before 'start_at'",
+		)
+	}
+}
+
+// buildScheduleSpec converts the spec of a Schedule's model into its Temporal form.
+func buildScheduleSpec(data *ScheduleResourceModel, diags *diag.Diagnostics) *temporalClient.ScheduleSpec {
+	spec := &temporalClient.ScheduleSpec{}
+
+	for i, interval := range data.Intervals {
+		intervalPath := path.Root("interval").AtListIndex(i)
+		var intervalSpec temporalClient.ScheduleIntervalSpec
+		parseDurationAttribute(&intervalSpec.Every, interval.Every, intervalPath.AtName("every"), diags)
+		parseDurationAttribute(&intervalSpec.Offset, interval.Offset, intervalPath.AtName("offset"), diags)
+		if intervalSpec.Every <= 0 && !interval.Every.IsUnknown() {
+			diags.AddAttributeError(
+				intervalPath.AtName("every"),
+				"Invalid Schedule interval",
+				"Schedule interval 'every' must be greater than zero",
+			)
+		}
+		spec.Intervals = append(spec.Intervals, intervalSpec)
+	}
+	spec.Calendars = buildScheduleCalendars(data.Calendars)
+	spec.Skip = buildScheduleCalendars(data.Skip)
+
+	parseTimeAttribute(&spec.StartAt, data.StartAt, path.Root("start_at"), diags)
+	parseTimeAttribute(&spec.EndAt, data.EndAt, path.Root("end_at"), diags)
+	checkScheduleTimeBounds(data.StartAt, data.EndAt, diags)
+	parseDurationAttribute(&spec.Jitter, data.Jitter, path.Root("jitter"), diags)
+	spec.TimeZoneName = data.TimeZoneName.ValueString()
+	for _, cronExpression := range data.CronExpressions {
+		spec.CronExpressions = append(spec.CronExpressions, cronExpression.ValueString())
+	}
+
+	return spec
+}
+
+// readScheduleSpec updates the spec of a Schedule's model from the spec described by the server.
+// Values equivalent to those already in the model, like `60m` for `1h0m0s`, are kept as written,
+// so that refreshing does not produce spurious differences.
+func readScheduleSpec(data *ScheduleResourceModel, spec *temporalClient.ScheduleSpec) {
+	if spec == nil {
+		spec = &temporalClient.ScheduleSpec{}
+	}
+
+	// The server describes cron expressions as calendars and intervals following the configured ones,
+	// so the expressions are kept as written and the specs expanded from them are left out
+	cronCalendars, cronIntervals := countCronExpressionSpecs(data.CronExpressions)
+	describedCalendars, describedIntervals := spec.Calendars, spec.Intervals
+	if len(describedCalendars) >= cronCalendars && len(describedIntervals) >= cronIntervals {
+		describedCalendars = describedCalendars[:len(describedCalendars)-cronCalendars]
+		describedIntervals = describedIntervals[:len(describedIntervals)-cronIntervals]
+	}
+
+	// Slicing to [:0:0] keeps a nil list null and an empty list empty, without aliasing the prior list
+	intervals := data.Intervals[:0:0]
+	for i, intervalSpec := range describedIntervals {
+		var prior ScheduleIntervalModel
+		if i < len(data.Intervals) {
+			prior = data.Intervals[i]
+		}
+		intervals = append(intervals, ScheduleIntervalModel{
+			Every:  durationValue(prior.Every, intervalSpec.Every),
+			Offset: durationValue(prior.Offset, intervalSpec.Offset),
+		})
+	}
+	data.Intervals = intervals
+	data.Calendars = readScheduleCalendars(data.Calendars, describedCalendars)
+	data.Skip = readScheduleCalendars(data.Skip, spec.Skip)
+
+	data.StartAt = timeValue(data.StartAt, spec.StartAt)
+	data.EndAt = timeValue(data.EndAt, spec.EndAt)
+	data.Jitter = durationValue(data.Jitter, spec.Jitter)
+	// The server takes the time zone of CRON_TZ= prefixes, which is not shown as `time_zone_name`
+	if !data.TimeZoneName.IsNull() || spec.TimeZoneName != cronExpressionsTimeZone(data.CronExpressions) {
+		data.TimeZoneName = stringValue(data.TimeZoneName, spec.TimeZoneName)
+	}
+}
+
+// countCronExpressionSpecs returns how many calendars and intervals the server expands
+// cronExpressions into.  Each expression becomes an interval if it is an `@every` macro,
+// or a calendar otherwise.
+func countCronExpressionSpecs(cronExpressions []types.String) (calendars int, intervals int) {
+	for _, cronExpression := range cronExpressions {
+		expression := strings.TrimSpace(cronExpression.ValueString())
+		// A CRON_TZ= or TZ= prefix only sets the time zone
+		if strings.HasPrefix(expression, "CRON_TZ=") || strings.HasPrefix(expression, "TZ=") {
+			if _, rest, ok := strings.Cut(expression, " "); ok {
+				expression = strings.TrimSpace(rest)
+			}
+		}
+		if strings.HasPrefix(expression, "@every") {
+			intervals++
+		} else {
+			calendars++
+		}
+	}
+	return calendars, intervals
+}
+
+// cronExpressionsTimeZone returns the time zone of the first CRON_TZ= or TZ= prefix of cronExpressions,
+// or an empty string if none has one.
+func cronExpressionsTimeZone(cronExpressions []types.String) string {
+	for _, cronExpression := range cronExpressions {
+		expression := strings.TrimSpace(cronExpression.ValueString())
+		for _, prefix := range []string{"CRON_TZ=", "TZ="} {
+			if strings.HasPrefix(expression, prefix) {
+				timeZone, _, _ := strings.Cut(strings.TrimPrefix(expression, prefix), " ")
+				return timeZone
+			}
+		}
+	}
+	return ""
+}
+
+// buildScheduleCalendars converts calendar blocks into their Temporal form.
+// Unset fields are left nil, for the Temporal SDK to apply its defaults.
+func buildScheduleCalendars(calendars []ScheduleCalendarModel) []temporalClient.ScheduleCalendarSpec {
+	var calendarSpecs []temporalClient.ScheduleCalendarSpec
+	for _, calendar := range calendars {
+		calendarSpecs = append(calendarSpecs, temporalClient.ScheduleCalendarSpec{
+			Second:     buildScheduleRanges(calendar.Second),
+			Minute:     buildScheduleRanges(calendar.Minute),
+			Hour:       buildScheduleRanges(calendar.Hour),
+			DayOfMonth: buildScheduleRanges(calendar.DayOfMonth),
+			Month:      buildScheduleRanges(calendar.Month),
+			Year:       buildScheduleRanges(calendar.Year),
+			DayOfWeek:  buildScheduleRanges(calendar.DayOfWeek),
+			Comment:    calendar.Comment.ValueString(),
+		})
+	}
+	return calendarSpecs
+}
+
+func buildScheduleRanges(ranges []ScheduleRangeModel) []temporalClient.ScheduleRange {
+	if ranges == nil {
+		return nil
+	}
+	scheduleRanges := make([]temporalClient.ScheduleRange, 0, len(ranges))
+	for _, r := range ranges {
+		scheduleRanges = append(scheduleRanges, temporalClient.ScheduleRange{
+			Start: int(r.Start.ValueInt64()),
+			End:   int(r.End.ValueInt64()),
+			Step:  int(r.Step.ValueInt64()),
+		})
+	}
+	return scheduleRanges
+}
+
+// readScheduleCalendars returns the calendars described by the server, keeping prior calendars
+// and fields that are equivalent to them as written.
+func readScheduleCalendars(prior []ScheduleCalendarModel, calendarSpecs []temporalClient.ScheduleCalendarSpec) []ScheduleCalendarModel {
+	calendars := prior[:0:0]
+	for i, calendarSpec := range calendarSpecs {
+		var priorCalendar ScheduleCalendarModel
+		if i < len(prior) {
+			priorCalendar = prior[i]
+		}
+		calendars = append(calendars, ScheduleCalendarModel{
+			Second:     readScheduleRanges(priorCalendar.Second, calendarSpec.Second, scheduleCalendarDefaults.second),
+			Minute:     readScheduleRanges(priorCalendar.Minute, calendarSpec.Minute, scheduleCalendarDefaults.minute),
+			Hour:       readScheduleRanges(priorCalendar.Hour, calendarSpec.Hour, scheduleCalendarDefaults.hour),
+			DayOfMonth: readScheduleRanges(priorCalendar.DayOfMonth, calendarSpec.DayOfMonth, scheduleCalendarDefaults.dayOfMonth),
+			Month:      readScheduleRanges(priorCalendar.Month, calendarSpec.Month, scheduleCalendarDefaults.month),
+			Year:       readScheduleRanges(priorCalendar.Year, calendarSpec.Year, scheduleCalendarDefaults.year),
+			DayOfWeek:  readScheduleRanges(priorCalendar.DayOfWeek, calendarSpec.DayOfWeek, scheduleCalendarDefaults.dayOfWeek),
+			Comment:    stringValue(priorCalendar.Comment, calendarSpec.Comment),
+		})
+	}
+	return calendars
+}
+
+// readScheduleRanges returns prior if it matches the same values as actual, or actual otherwise.
+// A null prior is kept if actual is the field's default.
+func readScheduleRanges(prior []ScheduleRangeModel, actual []temporalClient.ScheduleRange, defaults []temporalClient.ScheduleRange) []ScheduleRangeModel {
+	expected := defaults
+	if prior != nil {
+		expected = buildScheduleRanges(prior)
+	}
+	if reflect.DeepEqual(normalizeScheduleRanges(expected), normalizeScheduleRanges(actual)) {
+		return prior
+	}
+
+	ranges := make([]ScheduleRangeModel, 0, len(actual))
+	for _, r := range normalizeScheduleRanges(actual) {
+		rangeModel := ScheduleRangeModel{
+			Start: types.Int64Value(int64(r.Start)),
+			End:   types.Int64Null(),
+			Step:  types.Int64Null(),
+		}
+		if r.End != r.Start {
+			rangeModel.End = types.Int64Value(int64(r.End))
+		}
+		if r.Step != 1 {
+			rangeModel.Step = types.Int64Value(int64(r.Step))
+		}
+		ranges = append(ranges, rangeModel)
+	}
+	return ranges
+}
+
+// normalizeScheduleRanges returns ranges in the server's canonical form, where the end
+// of a range is never before its start and its step is at least 1.  Never nil.
+func normalizeScheduleRanges(ranges []temporalClient.ScheduleRange) []temporalClient.ScheduleRange {
+	normalized := make([]temporalClient.ScheduleRange, 0, len(ranges))
+	for _, r := range ranges {
+		if r.End < r.Start {
+			r.End = r.Start
+		}
+		if r.Step < 1 {
+			r.Step = 1
+		}
+		normalized = append(normalized, r)
+	}
+	return normalized
+}
+
+// parseTimeAttribute parses an RFC3339 time attribute into value, if it is set.
+func parseTimeAttribute(value *time.Time, attribute types.String, attributePath path.Path, diags *diag.Diagnostics) {
+	if attribute.IsNull() || attribute.IsUnknown() {
+		return
+	}
+	parsed, err := time.Parse(time.RFC3339, attribute.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			attributePath,
+			"Invalid Schedule time attribute",
+			fmt.Sprintf("Unable to parse RFC3339 time '%s': %s", attribute.ValueString(), err),
+		)
+		return
+	}
+	*value = parsed
+}
+
+// timeValue returns prior if it is the same instant as actual, or actual in RFC3339 otherwise.
+// A null prior is kept for a zero actual.
+func timeValue(prior types.String, actual time.Time) types.String {
+	if prior.IsNull() || prior.IsUnknown() {
+		if actual.IsZero() {
+			return types.StringNull()
+		}
+		return types.StringValue(actual.Format(time.RFC3339Nano))
+	}
+	if parsed, err := time.Parse(time.RFC3339, prior.ValueString()); err == nil && parsed.Equal(actual) {
+		return prior
+	}
+	if actual.IsZero() {
+		return types.StringNull()
+	}
+	return types.StringValue(actual.Format(time.RFC3339Nano))
+}
+
+// stringValue returns prior if it equals actual, or actual otherwise.
+// A null prior is kept for an empty actual.
+func stringValue(prior types.String, actual string) types.String {
+	if prior.IsNull() && actual == "" {
+		return types.StringNull()
+	}
+	if !prior.IsUnknown() && prior.ValueString() == actual {
+		return prior
+	}
+	return types.StringValue(actual)
+}
+
+// durationValue returns prior if it is the same duration as actual, or actual otherwise.
+// A null prior is kept for a zero actual, as zero is the default of optional durations.
+func durationValue(prior types.String, actual time.Duration) types.String {
+	if prior.IsNull() || prior.IsUnknown() {
+		if actual == 0 {
+			return types.StringNull()
+		}
+		return types.StringValue(actual.String())
+	}
+	if duration, err := time.ParseDuration(prior.ValueString()); err == nil && duration == actual {
+		return prior
+	}
+	return types.StringValue(actual.String())
+}
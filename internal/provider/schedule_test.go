@@ -0,0 +1,489 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/sdk/converter"
+
+	temporalClient "go.temporal.io/sdk/client"
+)
+
+func TestMergeScheduleDefaults(t *testing.T) {
+	defaults := map[string]string{"owner": "team-x", "managed_by": "terraform"}
+	values := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"owner": types.StringValue("team-y"),
+		"tier":  types.StringValue("gold"),
+	})
+
+	merged := mergeScheduleDefaults(defaults, values)
+	expected := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"owner":      types.StringValue("team-y"),
+		"managed_by": types.StringValue("terraform"),
+		"tier":       types.StringValue("gold"),
+	})
+	if !merged.Equal(expected) {
+		t.Errorf("expected %s, got %s", expected, merged)
+	}
+
+	if merged := mergeScheduleDefaults(defaults, types.MapNull(types.StringType)); len(merged.Elements()) != 2 {
+		t.Errorf("expected defaults only, got %s", merged)
+	}
+	if merged := mergeScheduleDefaults(nil, types.MapNull(types.StringType)); !merged.IsNull() {
+		t.Errorf("expected null without values, got %s", merged)
+	}
+	if merged := mergeScheduleDefaults(defaults, types.MapUnknown(types.StringType)); !merged.IsUnknown() {
+		t.Errorf("expected unknown for unknown values, got %s", merged)
+	}
+}
+
+func TestScheduleSpecIntervals(t *testing.T) {
+	data := &ScheduleResourceModel{
+		Intervals: []ScheduleIntervalModel{
+			{Every: types.StringValue("60m"), Offset: types.StringValue("15m")},
+			{Every: types.StringValue("24h"), Offset: types.StringNull()},
+		},
+	}
+
+	var diags diag.Diagnostics
+	spec := buildScheduleSpec(data, &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	expected := []temporalClient.ScheduleIntervalSpec{
+		{Every: time.Hour, Offset: 15 * time.Minute},
+		{Every: 24 * time.Hour},
+	}
+	if !reflect.DeepEqual(spec.Intervals, expected) {
+		t.Fatalf("expected %v, got %v", expected, spec.Intervals)
+	}
+
+	// Equivalent values read back from the server are kept as written
+	readScheduleSpec(data, spec)
+	if data.Intervals[0].Every.ValueString() != "60m" || !data.Intervals[1].Offset.IsNull() {
+		t.Errorf("expected configured intervals to be kept, got %v", data.Intervals)
+	}
+
+	// Drift is read back
+	spec.Intervals[1].Every = 12 * time.Hour
+	readScheduleSpec(data, spec)
+	if data.Intervals[1].Every.ValueString() != "12h0m0s" {
+		t.Errorf("expected drifted interval, got %v", data.Intervals[1])
+	}
+	spec.Intervals = append(spec.Intervals, temporalClient.ScheduleIntervalSpec{Every: time.Minute})
+	readScheduleSpec(data, spec)
+	if len(data.Intervals) != 3 || data.Intervals[2].Every.ValueString() != "1m0s" || !data.Intervals[2].Offset.IsNull() {
+		t.Errorf("expected added interval, got %v", data.Intervals)
+	}
+
+	invalid := &ScheduleResourceModel{Intervals: []ScheduleIntervalModel{{Every: types.StringValue("0s")}}}
+	diags = nil
+	buildScheduleSpec(invalid, &diags)
+	if !diags.HasError() {
+		t.Error("expected error for zero interval")
+	}
+}
+
+func TestScheduleSpecCalendars(t *testing.T) {
+	data := &ScheduleResourceModel{
+		Calendars: []ScheduleCalendarModel{{
+			Hour:      []ScheduleRangeModel{{Start: types.Int64Value(9), End: types.Int64Value(17), Step: types.Int64Null()}},
+			DayOfWeek: []ScheduleRangeModel{{Start: types.Int64Value(1), End: types.Int64Value(5), Step: types.Int64Null()}},
+			Minute:    []ScheduleRangeModel{{Start: types.Int64Value(30), End: types.Int64Null(), Step: types.Int64Null()}},
+			Comment:   types.StringNull(),
+		}},
+	}
+
+	var diags diag.Diagnostics
+	spec := buildScheduleSpec(data, &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	expected := []temporalClient.ScheduleCalendarSpec{{
+		Minute:    []temporalClient.ScheduleRange{{Start: 30}},
+		Hour:      []temporalClient.ScheduleRange{{Start: 9, End: 17}},
+		DayOfWeek: []temporalClient.ScheduleRange{{Start: 1, End: 5}},
+	}}
+	if !reflect.DeepEqual(spec.Calendars, expected) {
+		t.Fatalf("expected %v, got %v", expected, spec.Calendars)
+	}
+
+	// The server describes the calendar with the SDK's defaults, in its normalized form
+	described := &temporalClient.ScheduleSpec{Calendars: []temporalClient.ScheduleCalendarSpec{{
+		Second:     []temporalClient.ScheduleRange{{Start: 0, End: 0, Step: 1}},
+		Minute:     []temporalClient.ScheduleRange{{Start: 30, End: 30, Step: 1}},
+		Hour:       []temporalClient.ScheduleRange{{Start: 9, End: 17, Step: 1}},
+		DayOfMonth: []temporalClient.ScheduleRange{{Start: 1, End: 31, Step: 1}},
+		Month:      []temporalClient.ScheduleRange{{Start: 1, End: 12, Step: 1}},
+		Year:       []temporalClient.ScheduleRange{},
+		DayOfWeek:  []temporalClient.ScheduleRange{{Start: 1, End: 5, Step: 1}},
+	}}}
+	prior := data.Calendars[0]
+	readScheduleSpec(data, described)
+	if !reflect.DeepEqual(data.Calendars[0], prior) {
+		t.Errorf("expected no difference after refresh, got %+v", data.Calendars[0])
+	}
+
+	// Drift is read back
+	described.Calendars[0].Hour = []temporalClient.ScheduleRange{{Start: 8, End: 18, Step: 2}}
+	described.Calendars[0].Comment = "changed"
+	readScheduleSpec(data, described)
+	calendar := data.Calendars[0]
+	expectedHour := []ScheduleRangeModel{{Start: types.Int64Value(8), End: types.Int64Value(18), Step: types.Int64Value(2)}}
+	if !reflect.DeepEqual(calendar.Hour, expectedHour) || calendar.Comment.ValueString() != "changed" {
+		t.Errorf("expected drifted calendar, got %+v", calendar)
+	}
+	if calendar.Second != nil || calendar.DayOfMonth != nil || calendar.Year != nil {
+		t.Errorf("expected defaulted fields to stay null, got %+v", calendar)
+	}
+
+	// Imported calendars have no prior state
+	data.Calendars = nil
+	readScheduleSpec(data, described)
+	calendar = data.Calendars[0]
+	expectedMinute := []ScheduleRangeModel{{Start: types.Int64Value(30), End: types.Int64Null(), Step: types.Int64Null()}}
+	if !reflect.DeepEqual(calendar.Minute, expectedMinute) || calendar.Month != nil {
+		t.Errorf("unexpected imported calendar %+v", calendar)
+	}
+}
+
+func TestScheduleSpecCronExpressions(t *testing.T) {
+	data := &ScheduleResourceModel{
+		Intervals: []ScheduleIntervalModel{{Every: types.StringValue("1h"), Offset: types.StringNull()}},
+		CronExpressions: []types.String{
+			types.StringValue("CRON_TZ=Europe/Paris 0 9 * * MON-FRI"),
+			types.StringValue("@daily"),
+			types.StringValue("@every 30m"),
+		},
+	}
+
+	var diags diag.Diagnostics
+	spec := buildScheduleSpec(data, &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	expected := []string{"CRON_TZ=Europe/Paris 0 9 * * MON-FRI", "@daily", "@every 30m"}
+	if !reflect.DeepEqual(spec.CronExpressions, expected) {
+		t.Fatalf("expected %v, got %v", expected, spec.CronExpressions)
+	}
+
+	// The server describes the expressions as calendars and intervals, following the configured ones
+	described := &temporalClient.ScheduleSpec{
+		Calendars: []temporalClient.ScheduleCalendarSpec{
+			{Hour: []temporalClient.ScheduleRange{{Start: 9}}, DayOfWeek: []temporalClient.ScheduleRange{{Start: 1, End: 5}}},
+			{Hour: []temporalClient.ScheduleRange{{Start: 0}}},
+		},
+		Intervals: []temporalClient.ScheduleIntervalSpec{
+			{Every: time.Hour},
+			{Every: 30 * time.Minute},
+		},
+	}
+	readScheduleSpec(data, described)
+	if len(data.CronExpressions) != 3 || len(data.Calendars) != 0 || len(data.Intervals) != 1 {
+		t.Errorf("expected expanded cron expressions to be left out, got %+v", data)
+	}
+
+	// A configured interval that drifted away is still detected
+	described.Intervals = described.Intervals[1:]
+	readScheduleSpec(data, described)
+	if len(data.Intervals) != 0 {
+		t.Errorf("expected removed interval, got %+v", data.Intervals)
+	}
+}
+
+func TestScheduleSpecSkip(t *testing.T) {
+	wholeDay := func(month, day int64) ScheduleCalendarModel {
+		return ScheduleCalendarModel{
+			Second:     []ScheduleRangeModel{{Start: types.Int64Value(0), End: types.Int64Value(59), Step: types.Int64Null()}},
+			Minute:     []ScheduleRangeModel{{Start: types.Int64Value(0), End: types.Int64Value(59), Step: types.Int64Null()}},
+			Hour:       []ScheduleRangeModel{{Start: types.Int64Value(0), End: types.Int64Value(23), Step: types.Int64Null()}},
+			DayOfMonth: []ScheduleRangeModel{{Start: types.Int64Value(day), End: types.Int64Null(), Step: types.Int64Null()}},
+			Month:      []ScheduleRangeModel{{Start: types.Int64Value(month), End: types.Int64Null(), Step: types.Int64Null()}},
+			Comment:    types.StringValue("holiday"),
+		}
+	}
+	data := &ScheduleResourceModel{
+		Skip: []ScheduleCalendarModel{wholeDay(12, 25), wholeDay(1, 1)},
+	}
+
+	var diags diag.Diagnostics
+	spec := buildScheduleSpec(data, &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if len(spec.Skip) != 2 || len(spec.Calendars) != 0 {
+		t.Fatalf("expected skip calendars only, got %+v", spec)
+	}
+	if spec.Skip[0].Month[0].Start != 12 || spec.Skip[0].Hour[0].End != 23 || spec.Skip[1].Comment != "holiday" {
+		t.Errorf("unexpected skip calendars %+v", spec.Skip)
+	}
+
+	// A holiday removed on the server is read back
+	prior := data.Skip[0]
+	spec.Skip = spec.Skip[:1]
+	spec.Skip[0].DayOfWeek = scheduleCalendarDefaults.dayOfWeek
+	readScheduleSpec(data, spec)
+	if len(data.Skip) != 1 || !reflect.DeepEqual(data.Skip[0], prior) {
+		t.Errorf("expected remaining holiday to be kept as written, got %+v", data.Skip)
+	}
+}
+
+func TestScheduleSpecBounds(t *testing.T) {
+	data := &ScheduleResourceModel{
+		StartAt:      types.StringValue("2024-01-01T01:00:00+01:00"),
+		EndAt:        types.StringNull(),
+		Jitter:       types.StringValue("90s"),
+		TimeZoneName: types.StringValue("Europe/Paris"),
+	}
+
+	var diags diag.Diagnostics
+	spec := buildScheduleSpec(data, &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if !spec.StartAt.Equal(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)) || !spec.EndAt.IsZero() ||
+		spec.Jitter != 90*time.Second || spec.TimeZoneName != "Europe/Paris" {
+		t.Fatalf("unexpected spec %+v", spec)
+	}
+
+	// The server describes the same instant in UTC
+	described := &temporalClient.ScheduleSpec{
+		StartAt:      time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		Jitter:       90 * time.Second,
+		TimeZoneName: "Europe/Paris",
+	}
+	readScheduleSpec(data, described)
+	if data.StartAt.ValueString() != "2024-01-01T01:00:00+01:00" || !data.EndAt.IsNull() ||
+		data.Jitter.ValueString() != "90s" || data.TimeZoneName.ValueString() != "Europe/Paris" {
+		t.Errorf("expected configured values to be kept, got %+v", data)
+	}
+
+	described.EndAt = time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	described.TimeZoneName = "America/New_York"
+	readScheduleSpec(data, described)
+	if data.EndAt.ValueString() != "2025-01-01T00:00:00Z" || data.TimeZoneName.ValueString() != "America/New_York" {
+		t.Errorf("expected drifted values, got %+v", data)
+	}
+
+	// The time zone of a CRON_TZ= prefix is not read back as time_zone_name
+	cron := &ScheduleResourceModel{
+		CronExpressions: []types.String{types.StringValue("CRON_TZ=Asia/Tokyo 0 9 * * *")},
+		TimeZoneName:    types.StringNull(),
+	}
+	readScheduleSpec(cron, &temporalClient.ScheduleSpec{
+		Calendars:    []temporalClient.ScheduleCalendarSpec{{Hour: []temporalClient.ScheduleRange{{Start: 9}}}},
+		TimeZoneName: "Asia/Tokyo",
+	})
+	if !cron.TimeZoneName.IsNull() {
+		t.Errorf("expected null time zone, got %s", cron.TimeZoneName)
+	}
+
+	invalid := &ScheduleResourceModel{
+		StartAt: types.StringValue("2024-06-01T00:00:00Z"),
+		EndAt:   types.StringValue("2024-01-01T00:00:00Z"),
+	}
+	diags = nil
+	buildScheduleSpec(invalid, &diags)
+	if !diags.HasError() {
+		t.Error("expected error for end_at before start_at")
+	}
+	invalid = &ScheduleResourceModel{StartAt: types.StringValue("tomorrow")}
+	diags = nil
+	buildScheduleSpec(invalid, &diags)
+	if !diags.HasError() {
+		t.Error("expected error for invalid start_at")
+	}
+}
+
+func TestParseScheduleImportID(t *testing.T) {
+	testCases := map[string]struct {
+		namespace   string
+		scheduleID  string
+		expectError bool
+	}{
+		"my-schedule":              {scheduleID: "my-schedule"},
+		"other/my-schedule":        {namespace: "other", scheduleID: "my-schedule"},
+		"other/nested/my-schedule": {namespace: "other", scheduleID: "nested/my-schedule"},
+		"":                         {expectError: true},
+		"/my-schedule":             {expectError: true},
+		"other/":                   {expectError: true},
+	}
+
+	for importID, testCase := range testCases {
+		namespace, scheduleID, err := parseScheduleImportID(importID)
+		if (err != nil) != testCase.expectError {
+			t.Errorf("%q: unexpected error: %v", importID, err)
+			continue
+		}
+		if namespace != testCase.namespace || scheduleID != testCase.scheduleID {
+			t.Errorf("%q: expected %q/%q, got %q/%q", importID, testCase.namespace, testCase.scheduleID, namespace, scheduleID)
+		}
+	}
+}
+
+func TestCheckScheduleTimeBounds(t *testing.T) {
+	testCases := map[string]struct {
+		startAt     types.String
+		endAt       types.String
+		expectError bool
+	}{
+		"ordered":     {startAt: types.StringValue("2024-01-01T00:00:00Z"), endAt: types.StringValue("2024-06-01T00:00:00Z")},
+		"equal":       {startAt: types.StringValue("2024-01-01T01:00:00+01:00"), endAt: types.StringValue("2024-01-01T00:00:00Z")},
+		"reversed":    {startAt: types.StringValue("2024-06-01T00:00:00Z"), endAt: types.StringValue("2024-01-01T00:00:00Z"), expectError: true},
+		"start only":  {startAt: types.StringValue("2024-06-01T00:00:00Z"), endAt: types.StringNull()},
+		"unknown end": {startAt: types.StringValue("2024-06-01T00:00:00Z"), endAt: types.StringUnknown()},
+		"invalid end": {startAt: types.StringValue("2024-06-01T00:00:00Z"), endAt: types.StringValue("tomorrow")},
+	}
+
+	for name, testCase := range testCases {
+		var diags diag.Diagnostics
+		checkScheduleTimeBounds(testCase.startAt, testCase.endAt, &diags)
+		if diags.HasError() != testCase.expectError {
+			t.Errorf("%s: unexpected diagnostics: %v", name, diags)
+		}
+	}
+}
+
+func TestResolveScheduleImportID(t *testing.T) {
+	existing := map[string]bool{"nested/my-schedule": true}
+	describe := func(scheduleID string) error {
+		if scheduleID == "unavailable/my-schedule" {
+			return serviceerror.NewUnavailable("unavailable")
+		}
+		if !existing[scheduleID] {
+			return serviceerror.NewNotFound("not found")
+		}
+		return nil
+	}
+
+	testCases := map[string]struct {
+		namespace   string
+		scheduleID  string
+		expectError bool
+	}{
+		"my-schedule":              {scheduleID: "my-schedule"},
+		"nested/my-schedule":       {scheduleID: "nested/my-schedule"},
+		"other/my-schedule":        {namespace: "other", scheduleID: "my-schedule"},
+		"other/nested/my-schedule": {namespace: "other", scheduleID: "nested/my-schedule"},
+		"unavailable/my-schedule":  {expectError: true},
+		"other/":                   {expectError: true},
+	}
+
+	for importID, testCase := range testCases {
+		namespace, scheduleID, err := resolveScheduleImportID(importID, describe)
+		if (err != nil) != testCase.expectError {
+			t.Errorf("%q: unexpected error: %v", importID, err)
+			continue
+		}
+		if namespace != testCase.namespace || scheduleID != testCase.scheduleID {
+			t.Errorf("%q: expected %q/%q, got %q/%q", importID, testCase.namespace, testCase.scheduleID, namespace, scheduleID)
+		}
+	}
+}
+
+func TestReadSchedulePayloads(t *testing.T) {
+	dataConverter := converter.GetDefaultDataConverter()
+	payload := func(value interface{}) *commonpb.Payload {
+		p, err := dataConverter.ToPayload(value)
+		if err != nil {
+			t.Fatalf("unable to convert %v: %s", value, err)
+		}
+		return p
+	}
+
+	values := readSchedulePayloads(map[string]*commonpb.Payload{
+		"owner":                  payload("team-x"),
+		"retries":                payload(3),
+		"TemporalSchedulePaused": payload(false),
+	}, dataConverter)
+	expected := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"owner":   types.StringValue("team-x"),
+		"retries": types.StringValue("3"),
+	})
+	if !values.Equal(expected) {
+		t.Errorf("expected %s, got %s", expected, values)
+	}
+
+	if values := readSchedulePayloads(nil, dataConverter); !values.IsNull() {
+		t.Errorf("expected null without payloads, got %s", values)
+	}
+}
+
+func TestScheduleRangeOrderValidator(t *testing.T) {
+	rangeValue := func(start, end types.Int64) types.Object {
+		return types.ObjectValueMust(
+			map[string]attr.Type{"start": types.Int64Type, "end": types.Int64Type, "step": types.Int64Type},
+			map[string]attr.Value{"start": start, "end": end, "step": types.Int64Null()},
+		)
+	}
+	testCases := map[string]struct {
+		value       types.Object
+		expectError bool
+	}{
+		"ordered":     {value: rangeValue(types.Int64Value(9), types.Int64Value(17))},
+		"single":      {value: rangeValue(types.Int64Value(9), types.Int64Value(9))},
+		"end unset":   {value: rangeValue(types.Int64Value(9), types.Int64Null())},
+		"end unknown": {value: rangeValue(types.Int64Value(9), types.Int64Unknown())},
+		"reversed":    {value: rangeValue(types.Int64Value(17), types.Int64Value(9)), expectError: true},
+	}
+
+	for name, testCase := range testCases {
+		req := validator.ObjectRequest{Path: path.Root("calendar").AtListIndex(0).AtName("hour").AtListIndex(0), ConfigValue: testCase.value}
+		var resp validator.ObjectResponse
+		scheduleRangeOrderValidator{}.ValidateObject(context.Background(), req, &resp)
+		if resp.Diagnostics.HasError() != testCase.expectError {
+			t.Errorf("%s: unexpected diagnostics: %v", name, resp.Diagnostics)
+		}
+	}
+}
+
+func TestBuildScheduleWorkflowArgs(t *testing.T) {
+	workflowArgs := []ScheduleWorkflowArgModel{
+		{Value: types.StringValue("raw")},
+		{Value: types.StringValue(`{"id": 1}`), Encoding: types.StringValue("json/plain")},
+	}
+	dataConverter, err := buildDataConverter("binary/plain")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	testCases := map[string]struct {
+		defaultEncoding   string
+		expectedEncodings []string
+	}{
+		"sdk default":      {expectedEncodings: []string{converter.MetadataEncodingJSON, converter.MetadataEncodingJSON}},
+		"provider default": {defaultEncoding: "binary/plain", expectedEncodings: []string{converter.MetadataEncodingBinary, converter.MetadataEncodingJSON}},
+	}
+
+	for name, testCase := range testCases {
+		var diags diag.Diagnostics
+		args := buildScheduleWorkflowArgs(workflowArgs, testCase.defaultEncoding, &diags)
+		if diags.HasError() {
+			t.Fatalf("%s: unexpected diagnostics: %v", name, diags)
+		}
+		payloads, err := dataConverter.ToPayloads(args...)
+		if err != nil {
+			t.Fatalf("%s: unable to convert: %s", name, err)
+		}
+		for i, payload := range payloads.Payloads {
+			if encoding := string(payload.Metadata[converter.MetadataEncoding]); encoding != testCase.expectedEncodings[i] {
+				t.Errorf("%s: argument %d: expected encoding %q, got %q", name, i, testCase.expectedEncodings[i], encoding)
+			}
+		}
+	}
+
+	var diags diag.Diagnostics
+	buildScheduleWorkflowArgs(workflowArgs, "binary/null", &diags)
+	if diags.ErrorsCount() != 1 {
+		t.Errorf("expected an error for the argument that cannot be null, got %v", diags)
+	}
+}
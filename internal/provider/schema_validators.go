@@ -0,0 +1,180 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+	_ "time/tzdata" // time zones are validated without relying on the system database
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// stringOneOfValidator checks that a string attribute is one of a set of values.
+type stringOneOfValidator struct {
+	values []string
+}
+
+// stringOneOf returns a validator checking that a string attribute is one of values.
+func stringOneOf(values ...string) validator.String {
+	return stringOneOfValidator{values: values}
+}
+
+func (v stringOneOfValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("value must be one of: %s", strings.Join(v.values, ", "))
+}
+
+func (v stringOneOfValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v stringOneOfValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	value := req.ConfigValue.ValueString()
+	for _, allowed := range v.values {
+		if value == allowed {
+			return
+		}
+	}
+	resp.Diagnostics.AddAttributeError(
+		req.Path,
+		"Invalid attribute value",
+		fmt.Sprintf("Unsupported value '%s', %s", value, v.Description(ctx)),
+	)
+}
+
+// int64BetweenValidator checks that an integer attribute is within [lo, hi].
+type int64BetweenValidator struct {
+	lo, hi int64
+}
+
+// int64Between returns a validator checking that an integer attribute is between lo and hi, inclusive.
+func int64Between(lo, hi int64) validator.Int64 {
+	return int64BetweenValidator{lo: lo, hi: hi}
+}
+
+// int64AtLeast returns a validator checking that an integer attribute is at least lo.
+func int64AtLeast(lo int64) validator.Int64 {
+	return int64BetweenValidator{lo: lo, hi: math.MaxInt64}
+}
+
+func (v int64BetweenValidator) Description(ctx context.Context) string {
+	if v.hi == math.MaxInt64 {
+		return fmt.Sprintf("value must be at least %d", v.lo)
+	}
+	return fmt.Sprintf("value must be between %d and %d", v.lo, v.hi)
+}
+
+func (v int64BetweenValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v int64BetweenValidator) ValidateInt64(ctx context.Context, req validator.Int64Request, resp *validator.Int64Response) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	value := req.ConfigValue.ValueInt64()
+	if value < v.lo || value > v.hi {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid attribute value",
+			fmt.Sprintf("Value %d is out of range, %s", value, v.Description(ctx)),
+		)
+	}
+}
+
+// timeZoneValidator checks that a string attribute is an IANA time zone name known to Go's time zone database.
+type timeZoneValidator struct{}
+
+func (v timeZoneValidator) Description(ctx context.Context) string {
+	return "value must be an IANA time zone name, such as Europe/Paris"
+}
+
+func (v timeZoneValidator) MarkdownDescription(ctx context.Context) string {
+	return "value must be an IANA time zone name, such as `Europe/Paris`"
+}
+
+func (v timeZoneValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	name := req.ConfigValue.ValueString()
+	// "Local" is the time zone of the machine running Terraform, unknown to the server
+	_, err := time.LoadLocation(name)
+	if err == nil && name == "Local" {
+		err = fmt.Errorf("the machine's local time zone is not supported")
+	}
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid time zone name",
+			fmt.Sprintf("Unable to load time zone '%s': %s; %s", name, err, v.Description(ctx)),
+		)
+	}
+}
+
+// durationValidator checks that a string attribute is a duration, such as `30s`, that is not negative,
+// or greater than zero if positive is set.
+type durationValidator struct {
+	positive bool
+}
+
+func (v durationValidator) Description(ctx context.Context) string {
+	if v.positive {
+		return "value must be a duration greater than zero, such as 30s"
+	}
+	return "value must be a duration that is not negative, such as 30s"
+}
+
+func (v durationValidator) MarkdownDescription(ctx context.Context) string {
+	if v.positive {
+		return "value must be a duration greater than zero, such as `30s`"
+	}
+	return "value must be a duration that is not negative, such as `30s`"
+}
+
+func (v durationValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	value := req.ConfigValue.ValueString()
+	duration, err := time.ParseDuration(value)
+	if err == nil && (duration < 0 || (v.positive && duration == 0)) {
+		err = fmt.Errorf("out of range")
+	}
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid duration",
+			fmt.Sprintf("Unable to use duration '%s': %s; %s", value, err, v.Description(ctx)),
+		)
+	}
+}
+
+// rfc3339Validator checks that a string attribute is a time in RFC3339 format.
+type rfc3339Validator struct{}
+
+func (v rfc3339Validator) Description(ctx context.Context) string {
+	return "value must be a time in RFC3339 format, such as 2024-01-01T00:00:00Z"
+}
+
+func (v rfc3339Validator) MarkdownDescription(ctx context.Context) string {
+	return "value must be a time in RFC3339 format, such as `2024-01-01T00:00:00Z`"
+}
+
+func (v rfc3339Validator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	value := req.ConfigValue.ValueString()
+	if _, err := time.Parse(time.RFC3339, value); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid time",
+			fmt.Sprintf("Unable to parse time '%s': %s; %s", value, err, v.Description(ctx)),
+		)
+	}
+}
@@ -0,0 +1,135 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestStringOneOf(t *testing.T) {
+	testCases := map[string]struct {
+		value       types.String
+		expectError bool
+	}{
+		"allowed":     {value: types.StringValue("json/plain")},
+		"not allowed": {value: types.StringValue("binary/gzip"), expectError: true},
+		"null":        {value: types.StringNull()},
+		"unknown":     {value: types.StringUnknown()},
+	}
+
+	for name, testCase := range testCases {
+		req := validator.StringRequest{Path: path.Root("encoding"), ConfigValue: testCase.value}
+		var resp validator.StringResponse
+		stringOneOf("json/plain", "binary/plain").ValidateString(context.Background(), req, &resp)
+		if resp.Diagnostics.HasError() != testCase.expectError {
+			t.Errorf("%s: unexpected diagnostics: %v", name, resp.Diagnostics)
+		}
+	}
+}
+
+func TestInt64Between(t *testing.T) {
+	testCases := map[string]struct {
+		validator   validator.Int64
+		value       types.Int64
+		expectError bool
+	}{
+		"within":         {validator: int64Between(0, 23), value: types.Int64Value(23)},
+		"below":          {validator: int64Between(1, 31), value: types.Int64Value(0), expectError: true},
+		"above":          {validator: int64Between(0, 59), value: types.Int64Value(60), expectError: true},
+		"at least":       {validator: int64AtLeast(1), value: types.Int64Value(1)},
+		"below at least": {validator: int64AtLeast(1), value: types.Int64Value(0), expectError: true},
+		"null":           {validator: int64Between(0, 23), value: types.Int64Null()},
+		"unknown":        {validator: int64AtLeast(1), value: types.Int64Unknown()},
+	}
+
+	for name, testCase := range testCases {
+		req := validator.Int64Request{Path: path.Root("hour"), ConfigValue: testCase.value}
+		var resp validator.Int64Response
+		testCase.validator.ValidateInt64(context.Background(), req, &resp)
+		if resp.Diagnostics.HasError() != testCase.expectError {
+			t.Errorf("%s: unexpected diagnostics: %v", name, resp.Diagnostics)
+		}
+	}
+}
+
+func TestTimeZoneValidator(t *testing.T) {
+	testCases := map[string]struct {
+		value       types.String
+		expectError bool
+	}{
+		"iana":    {value: types.StringValue("Europe/Paris")},
+		"utc":     {value: types.StringValue("UTC")},
+		"typo":    {value: types.StringValue("Europe/Pariss"), expectError: true},
+		"local":   {value: types.StringValue("Local"), expectError: true},
+		"null":    {value: types.StringNull()},
+		"unknown": {value: types.StringUnknown()},
+	}
+
+	for name, testCase := range testCases {
+		req := validator.StringRequest{Path: path.Root("time_zone_name"), ConfigValue: testCase.value}
+		var resp validator.StringResponse
+		timeZoneValidator{}.ValidateString(context.Background(), req, &resp)
+		if resp.Diagnostics.HasError() != testCase.expectError {
+			t.Errorf("%s: unexpected diagnostics: %v", name, resp.Diagnostics)
+		}
+		if testCase.expectError {
+			if pathDiag, ok := resp.Diagnostics.Errors()[0].(diag.DiagnosticWithPath); !ok || !pathDiag.Path().Equal(path.Root("time_zone_name")) {
+				t.Errorf("%s: expected error on time_zone_name, got %v", name, resp.Diagnostics)
+			}
+		}
+	}
+}
+
+func TestDurationValidator(t *testing.T) {
+	testCases := map[string]struct {
+		validator   validator.String
+		value       types.String
+		expectError bool
+	}{
+		"duration":          {validator: durationValidator{}, value: types.StringValue("30s")},
+		"zero":              {validator: durationValidator{}, value: types.StringValue("0s")},
+		"negative":          {validator: durationValidator{}, value: types.StringValue("-1m"), expectError: true},
+		"typo":              {validator: durationValidator{}, value: types.StringValue("30 seconds"), expectError: true},
+		"positive":          {validator: durationValidator{positive: true}, value: types.StringValue("1h")},
+		"zero positive":     {validator: durationValidator{positive: true}, value: types.StringValue("0s"), expectError: true},
+		"negative positive": {validator: durationValidator{positive: true}, value: types.StringValue("-1h"), expectError: true},
+		"null":              {validator: durationValidator{positive: true}, value: types.StringNull()},
+		"unknown":           {validator: durationValidator{positive: true}, value: types.StringUnknown()},
+	}
+
+	for name, testCase := range testCases {
+		req := validator.StringRequest{Path: path.Root("every"), ConfigValue: testCase.value}
+		var resp validator.StringResponse
+		testCase.validator.ValidateString(context.Background(), req, &resp)
+		if resp.Diagnostics.HasError() != testCase.expectError {
+			t.Errorf("%s: unexpected diagnostics: %v", name, resp.Diagnostics)
+		}
+	}
+}
+
+func TestRFC3339Validator(t *testing.T) {
+	testCases := map[string]struct {
+		value       types.String
+		expectError bool
+	}{
+		"utc":     {value: types.StringValue("2024-01-01T00:00:00Z")},
+		"offset":  {value: types.StringValue("2024-01-01T01:00:00+01:00")},
+		"date":    {value: types.StringValue("2024-01-01"), expectError: true},
+		"words":   {value: types.StringValue("tomorrow"), expectError: true},
+		"null":    {value: types.StringNull()},
+		"unknown": {value: types.StringUnknown()},
+	}
+
+	for name, testCase := range testCases {
+		req := validator.StringRequest{Path: path.Root("start_at"), ConfigValue: testCase.value}
+		var resp validator.StringResponse
+		rfc3339Validator{}.ValidateString(context.Background(), req, &resp)
+		if resp.Diagnostics.HasError() != testCase.expectError {
+			t.Errorf("%s: unexpected diagnostics: %v", name, resp.Diagnostics)
+		}
+	}
+}
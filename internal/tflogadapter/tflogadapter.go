@@ -0,0 +1,82 @@
+// Package tflogadapter forwards Temporal SDK logs to Terraform's logging.
+//
+// Logs are written to the `temporal-sdk` tflog subsystem, so their level follows
+// TF_LOG and TF_LOG_PROVIDER, and may be set separately with TF_LOG_PROVIDER_TEMPORAL_SDK.
+package tflogadapter
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"go.temporal.io/sdk/log"
+)
+
+// Subsystem is the tflog subsystem receiving the Temporal SDK logs.
+const Subsystem = "temporal-sdk"
+
+// LevelEnvVar is the environment variable overriding the level of the Subsystem.
+const LevelEnvVar = "TF_LOG_PROVIDER_TEMPORAL_SDK"
+
+// Ensure TflogAdapter satisfies the Temporal SDK's Logger interface.
+var _ log.Logger = &TflogAdapter{}
+
+type TflogAdapter struct {
+	ctx context.Context
+}
+
+// NewTflogAdapter creates a Temporal SDK Logger from the tflog logger in ctx.
+// ctx must carry the provider's root logger, as contexts passed to the provider do.
+func NewTflogAdapter(ctx context.Context) *TflogAdapter {
+	return &TflogAdapter{
+		ctx: tflog.NewSubsystem(ctx, Subsystem,
+			tflog.WithLevelFromEnv(LevelEnvVar),
+			// Skip one call frame to exclude the adapter itself
+			tflog.WithAdditionalLocationOffset(1),
+		),
+	}
+}
+
+func (log *TflogAdapter) fields(keyvals []interface{}) map[string]interface{} {
+	if len(keyvals)%2 != 0 {
+		return map[string]interface{}{"error": fmt.Sprintf("odd number of keyvals pairs: %v", keyvals)}
+	}
+
+	fields := make(map[string]interface{}, len(keyvals)/2)
+	for i := 0; i < len(keyvals); i += 2 {
+		key, ok := keyvals[i].(string)
+		if !ok {
+			key = fmt.Sprintf("%v", keyvals[i])
+		}
+		value := keyvals[i+1]
+		if err, ok := value.(error); ok {
+			value = err.Error()
+		}
+		fields[key] = value
+	}
+	return fields
+}
+
+func (log *TflogAdapter) Debug(msg string, keyvals ...interface{}) {
+	tflog.SubsystemDebug(log.ctx, Subsystem, msg, log.fields(keyvals))
+}
+
+func (log *TflogAdapter) Info(msg string, keyvals ...interface{}) {
+	tflog.SubsystemInfo(log.ctx, Subsystem, msg, log.fields(keyvals))
+}
+
+func (log *TflogAdapter) Warn(msg string, keyvals ...interface{}) {
+	tflog.SubsystemWarn(log.ctx, Subsystem, msg, log.fields(keyvals))
+}
+
+func (log *TflogAdapter) Error(msg string, keyvals ...interface{}) {
+	tflog.SubsystemError(log.ctx, Subsystem, msg, log.fields(keyvals))
+}
+
+func (log *TflogAdapter) With(keyvals ...interface{}) log.Logger {
+	ctx := log.ctx
+	for key, value := range log.fields(keyvals) {
+		ctx = tflog.SubsystemSetField(ctx, Subsystem, key, value)
+	}
+	return &TflogAdapter{ctx: ctx}
+}
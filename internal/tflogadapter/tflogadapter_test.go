@@ -0,0 +1,46 @@
+package tflogadapter
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+)
+
+func TestTflogAdapter(t *testing.T) {
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+
+	logger := NewTflogAdapter(ctx).With("Namespace", "default")
+	logger.Warn("poll failed", "Attempt", 2, "Error", errors.New("unavailable"))
+	logger.Info("odd", "dangling")
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	if err != nil {
+		t.Fatalf("unable to decode log output: %s", err)
+	}
+	if len(entries) != 2 {
+		t.Fatalf("expected 2 log entries, got %d: %v", len(entries), entries)
+	}
+
+	entry := entries[0]
+	expected := map[string]interface{}{
+		"@level":    "warn",
+		"@message":  "poll failed",
+		"@module":   "provider." + Subsystem,
+		"Namespace": "default",
+		"Attempt":   float64(2),
+		"Error":     "unavailable",
+	}
+	for k, v := range expected {
+		if entry[k] != v {
+			t.Errorf("field %q: expected %v, got %v", k, v, entry[k])
+		}
+	}
+
+	if _, ok := entries[1]["error"]; !ok {
+		t.Errorf("expected an error field for odd keyvals, got %v", entries[1])
+	}
+}